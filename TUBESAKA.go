@@ -1,191 +1,2503 @@
-package main
-
-import (
-	"fmt"
-	"math"
-	"time"
-)
-
-const (
-	numRuns    = 5      // Jumlah pengujian untuk perbandingan
-	warmUpRuns = 1000   // Jumlah iterasi pemanasan (warm-up)
-	iterations = 100000 // Jumlah iterasi untuk pengukuran waktu
-	epsilon    = 1e-10  // Konstanta untuk perbandingan floating point
-)
-
-// GeometricCalculator holds the parameters for a geometric sequence
-type GeometricCalculator struct {
-	a float64 // Suku pertama
-	r float64 // Rasio
-	n int     // Jumlah suku
-}
-
-// measureExecutionTime measures the execution time of a function in nanoseconds
-func measureExecutionTime(f func()) float64 {
-	// Warm-up phase to stabilize any jitter
-	for i := 0; i < warmUpRuns; i++ {
-		f()
-	}
-
-	// Measure execution time
-	var totalDuration time.Duration
-	for run := 0; run < iterations; run++ {
-		start := time.Now()
-		f()
-		totalDuration += time.Since(start)
-	}
-
-	// Return average duration in nanoseconds
-	return float64(totalDuration.Nanoseconds()) / float64(iterations)
-}
-
-// GeometricSumIterative calculates the sum of a geometric sequence using iteration
-func (g *GeometricCalculator) GeometricSumIterative() float64 {
-	sum := 0.0
-	term := g.a
-	for i := 0; i < g.n; i++ {
-		sum += term
-		term *= g.r
-	}
-	return sum
-}
-
-// GeometricSumRecursive calculates the sum of a geometric sequence using recursion
-func (g *GeometricCalculator) GeometricSumRecursive() float64 {
-	memo := make(map[int]float64)
-
-	var recursive func(a, r float64, n int) float64
-	recursive = func(a, r float64, n int) float64 {
-		if n == 0 {
-			return 0
-		}
-		if val, found := memo[n]; found {
-			return val
-		}
-		memo[n] = a + recursive(a*r, r, n-1)
-		return memo[n]
-	}
-
-	return recursive(g.a, g.r, g.n)
-}
-
-// GeometricSumFormula calculates the sum of a geometric sequence using the closed-form formula
-func (g *GeometricCalculator) GeometricSumFormula() float64 {
-	if math.Abs(g.r-1.0) < epsilon {
-		return g.a * float64(g.n)
-	}
-	return g.a * (1 - math.Pow(g.r, float64(g.n))) / (1 - g.r)
-}
-
-// validateInput prompts the user to input valid parameters for the geometric sequence
-func validateInput() (float64, float64, int, error) {
-	var a, r float64
-	var n int
-
-	fmt.Print("Suku pertama (a): ")
-	if _, err := fmt.Scan(&a); err != nil || a <= 0 {
-		return 0, 0, 0, fmt.Errorf("harap masukkan nilai a > 0")
-	}
-
-	fmt.Print("Rasio (r): ")
-	if _, err := fmt.Scan(&r); err != nil || r <= 0 {
-		return 0, 0, 0, fmt.Errorf("harap masukkan nilai r > 0")
-	}
-
-	fmt.Print("Jumlah suku (n): ")
-	if _, err := fmt.Scan(&n); err != nil || n <= 0 {
-		return 0, 0, 0, fmt.Errorf("harap masukkan nilai n > 0")
-	}
-
-	return a, r, n, nil
-}
-
-// ComparisonProgram runs the comparison between iterative and recursive methods
-func ComparisonProgram() {
-	fmt.Println("\n=== Perbandingan Metode ===")
-	a, r, n, err := validateInput()
-	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		return
-	}
-
-	calc := &GeometricCalculator{a: a, r: r, n: n}
-
-	// Measure iterative time
-	iterativeTimes := make([]float64, numRuns)
-	var resultIterative float64
-	for i := 0; i < numRuns; i++ {
-		iterativeTimes[i] = measureExecutionTime(func() {
-			resultIterative = calc.GeometricSumIterative()
-		})
-	}
-
-	// Measure recursive time
-	recursiveTimes := make([]float64, numRuns)
-	var resultRecursive float64
-	for i := 0; i < numRuns; i++ {
-		recursiveTimes[i] = measureExecutionTime(func() {
-			resultRecursive = calc.GeometricSumRecursive()
-		})
-	}
-
-	// Calculate average times
-	avgIterativeTime := 0.0
-	avgRecursiveTime := 0.0
-	for i := 0; i < numRuns; i++ {
-		avgIterativeTime += iterativeTimes[i]
-		avgRecursiveTime += recursiveTimes[i]
-	}
-	avgIterativeTime /= float64(numRuns)
-	avgRecursiveTime /= float64(numRuns)
-
-	// Formula result
-	resultFormula := calc.GeometricSumFormula()
-
-	// Output results
-	fmt.Println("\n=== Hasil Perbandingan ===")
-	fmt.Printf("Iteratif: %.3f (waktu: %.3f ns)\n", resultIterative, avgIterativeTime)
-	fmt.Printf("Rekursif: %.3f (waktu: %.3f ns)\n", resultRecursive, avgRecursiveTime)
-	fmt.Printf("Hasil: %.2f\n", resultFormula)
-
-	// Performance ratio
-	if avgIterativeTime > 0 {
-		ratio := avgRecursiveTime / avgIterativeTime
-		fmt.Printf("\nPerbandingan waktu (Rekursif/Iteratif): %.2fx\n", ratio)
-		if ratio > 1 {
-			fmt.Printf("Metode iteratif lebih cepat sebesar %.2f%%\n", (ratio-1)*100)
-		} else {
-			fmt.Printf("Metode rekursif lebih cepat sebesar %.2f%%\n", (1-ratio)*100)
-		}
-	}
-}
-
-// main is the entry point of the program
-func main() {
-	for {
-		fmt.Println("========================================================")
-		fmt.Println("   PERBANDINGAN ALGORITMA ITERATIF DAN REKURSIF")
-		fmt.Println("           DALAM MENGHITUNG DERET GEOMETRI")
-		fmt.Println("========================================================")
-		fmt.Println("\nPilih mode program:")
-		fmt.Println("1. Perbandingan Metode iteratif dan rekursif")
-		fmt.Println("2. Keluar")
-		fmt.Print("\nMasukkan pilihan Anda (1/2): ")
-
-		var choice int
-		fmt.Scanln(&choice)
-
-		switch choice {
-		case 1:
-			ComparisonProgram()
-		case 2:
-			fmt.Println("Terima kasih telah menggunakan program ini. Sampai jumpa!")
-			return
-		default:
-			fmt.Println("Pilihan tidak valid! Harap pilih 1 atau 2.")
-		}
-
-		fmt.Println("\nTekan Enter untuk kembali ke menu utama...")
-		fmt.Scanln() // Tunggu pengguna untuk melanjutkan
-	}
-}
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	numRuns    = 5      // Jumlah pengujian untuk perbandingan
+	warmUpRuns = 1000   // Jumlah iterasi pemanasan (warm-up)
+	iterations = 100000 // Jumlah iterasi untuk pengukuran waktu
+	epsilon    = 1e-10  // Konstanta untuk perbandingan floating point
+
+	maxTraceDepth = 10 // Batas kedalaman jejak rekursi yang ditampilkan
+
+	machineEpsilon = 0x1p-52 // Selisih antara 1 dan float64 berikutnya
+
+	defaultMaxRecursionDepth = 100000 // Batas bawaan n untuk tolok ukur rekursif
+
+	maxChainedN = 10000000 // Batas n deret kedua pada alur dua tahap
+
+	maxPrintedRows = 50 // Batas baris tabel per suku yang dicetak
+)
+
+// GeometricCalculator holds the parameters for a geometric sequence
+type GeometricCalculator struct {
+	a float64 // Suku pertama
+	r float64 // Rasio
+	n int     // Jumlah suku
+
+	prefixSums  []float64    // Jumlah parsial tersimpan, diisi saat pertama kali diminta
+	prefixBuilt seriesParams // Parameter saat prefixSums dibangun
+}
+
+// seriesParams identifies the parameters a cached computation was built for
+type seriesParams struct {
+	a float64
+	r float64
+	n int
+}
+
+// measureExecutionTimeWith measures the execution time of a function in nanoseconds using
+// the given number of warm-up calls and timed iterations
+func measureExecutionTimeWith(f func(), warmUp, iters int) float64 {
+	// Warm-up phase to stabilize any jitter
+	for i := 0; i < warmUp; i++ {
+		f()
+	}
+
+	// Measure execution time
+	var totalDuration time.Duration
+	for run := 0; run < iters; run++ {
+		start := time.Now()
+		f()
+		totalDuration += time.Since(start)
+	}
+
+	// Return average duration in nanoseconds
+	return float64(totalDuration.Nanoseconds()) / float64(iters)
+}
+
+// GeometricSumIterative calculates the sum of a geometric sequence using iteration
+func (g *GeometricCalculator) GeometricSumIterative() float64 {
+	sum := 0.0
+	term := g.a
+	for i := 0; i < g.n; i++ {
+		sum += term
+		term *= g.r
+	}
+	return sum
+}
+
+// GeometricSumKahan calculates the sum iteratively using Kahan compensated summation
+func (g *GeometricCalculator) GeometricSumKahan() float64 {
+	sum := 0.0
+	compensation := 0.0
+	term := g.a
+	for i := 0; i < g.n; i++ {
+		y := term - compensation
+		t := sum + y
+		compensation = (t - sum) - y
+		sum = t
+		term *= g.r
+	}
+	return sum
+}
+
+// GeometricSumNeumaier calculates the sum iteratively using Neumaier's improved Kahan
+// summation, which keeps the compensation even when a term is larger than the running sum
+func (g *GeometricCalculator) GeometricSumNeumaier() float64 {
+	sum := 0.0
+	compensation := 0.0
+	term := g.a
+	for i := 0; i < g.n; i++ {
+		t := sum + term
+		if math.Abs(sum) >= math.Abs(term) {
+			compensation += (sum - t) + term
+		} else {
+			compensation += (term - t) + sum
+		}
+		sum = t
+		term *= g.r
+	}
+	return sum + compensation
+}
+
+// GeometricSumHybrid calculates the sum iteratively, accumulating terms in a float32 partial
+// sum that is folded into a float64 total every foldEvery terms to bound error growth
+func (g *GeometricCalculator) GeometricSumHybrid(foldEvery int) float64 {
+	if foldEvery < 1 {
+		foldEvery = 1
+	}
+	total := 0.0
+	var partial float32
+	term := g.a
+	for i := 0; i < g.n; i++ {
+		partial += float32(term)
+		if (i+1)%foldEvery == 0 {
+			total += float64(partial)
+			partial = 0
+		}
+		term *= g.r
+	}
+	return total + float64(partial)
+}
+
+// SubdividedSum calculates the sum when every term period is split into m compounding
+// sub-steps with ratio r^(1/m), adding each of the n·m sub-terms. Every m-th sub-term
+// coincides with a term of the original series.
+func (g *GeometricCalculator) SubdividedSum(m int) float64 {
+	if m < 1 {
+		m = 1
+	}
+	step := math.Pow(g.r, 1/float64(m))
+	sum := 0.0
+	for i := 0; i < g.n; i++ {
+		term := g.a * math.Pow(g.r, float64(i))
+		for j := 0; j < m; j++ {
+			sum += term
+			term *= step
+		}
+	}
+	return sum
+}
+
+// GeometricSumInterval calculates the sum iteratively in interval arithmetic, widening every
+// rounded result outward by one ULP so that [lo, hi] is guaranteed to enclose the exact sum
+func (g *GeometricCalculator) GeometricSumInterval() (lo, hi float64) {
+	down := func(x float64) float64 { return math.Nextafter(x, math.Inf(-1)) }
+	up := func(x float64) float64 { return math.Nextafter(x, math.Inf(1)) }
+
+	termLo, termHi := g.a, g.a
+	for i := 0; i < g.n; i++ {
+		lo = down(lo + termLo)
+		hi = up(hi + termHi)
+
+		p, q := termLo*g.r, termHi*g.r
+		termLo, termHi = down(math.Min(p, q)), up(math.Max(p, q))
+	}
+	return lo, hi
+}
+
+// AlternatingRatioSum calculates iteratively the sum of n terms starting at a where odd steps
+// multiply the term by r1 and even steps by r2 (the calculator's own r is not used)
+func (g *GeometricCalculator) AlternatingRatioSum(r1, r2 float64) float64 {
+	sum := 0.0
+	term := g.a
+	for i := 0; i < g.n; i++ {
+		sum += term
+		if i%2 == 0 {
+			term *= r1
+		} else {
+			term *= r2
+		}
+	}
+	return sum
+}
+
+// DecayingRatioSum calculates iteratively the sum of n terms where the ratio itself shrinks
+// by d every step: term_{i+1} = term_i·r·d^i. The terms are a·r^i·d^(i(i-1)/2), a q-series
+// with no simple closed form, so only the iterative sum is provided.
+func (g *GeometricCalculator) DecayingRatioSum(d float64) float64 {
+	sum := 0.0
+	term, ratio := g.a, g.r
+	for i := 0; i < g.n; i++ {
+		sum += term
+		term *= ratio
+		ratio *= d
+	}
+	return sum
+}
+
+// alternatingRatioSumClosed calculates AlternatingRatioSum in closed form: each pair of terms
+// a·(r1·r2)^k·(1 + r1) forms a geometric series with ratio r1·r2, plus a final unpaired
+// term when n is odd
+func (g *GeometricCalculator) alternatingRatioSumClosed(r1, r2 float64) float64 {
+	pairs := &GeometricCalculator{a: g.a * (1 + r1), r: r1 * r2, n: g.n / 2}
+	sum := pairs.GeometricSumFormula()
+	if g.n%2 == 1 {
+		sum += g.a * math.Pow(r1*r2, float64(g.n/2))
+	}
+	return sum
+}
+
+// GeometricSumBig calculates the sum iteratively in big.Float arithmetic with prec bits of
+// mantissa, serving as a high-precision reference for the float64 methods
+func (g *GeometricCalculator) GeometricSumBig(prec uint) *big.Float {
+	sum := new(big.Float).SetPrec(prec)
+	term := new(big.Float).SetPrec(prec).SetFloat64(g.a)
+	r := new(big.Float).SetPrec(prec).SetFloat64(g.r)
+	for i := 0; i < g.n; i++ {
+		sum.Add(sum, term)
+		term.Mul(term, r)
+	}
+	return sum
+}
+
+// SumWithEffectiveCount calculates the sum iteratively and also returns the number of
+// leading terms after which no term contributed more than relTol of the running sum
+func (g *GeometricCalculator) SumWithEffectiveCount(relTol float64) (sum float64, effectiveN int) {
+	term := g.a
+	for i := 0; i < g.n; i++ {
+		sum += term
+		if math.Abs(term) > relTol*math.Abs(sum) {
+			effectiveN = i + 1
+		}
+		term *= g.r
+	}
+	return sum, effectiveN
+}
+
+// PartialSumTo returns the sum of the first k terms. The prefix sums of all n terms are
+// computed once on the first query and reused until a, r or n change.
+func (g *GeometricCalculator) PartialSumTo(k int) (float64, error) {
+	if k < 0 || k > g.n {
+		return 0, fmt.Errorf("k harus di antara 0 dan %d", g.n)
+	}
+
+	params := seriesParams{a: g.a, r: g.r, n: g.n}
+	if g.prefixSums == nil || g.prefixBuilt != params {
+		g.prefixSums = make([]float64, g.n+1)
+		term := g.a
+		for i := 0; i < g.n; i++ {
+			g.prefixSums[i+1] = g.prefixSums[i] + term
+			term *= g.r
+		}
+		g.prefixBuilt = params
+	}
+	return g.prefixSums[k], nil
+}
+
+// SumDifference returns S(n2) - S(n1), the sum of terms n1+1 through n2, directly from the
+// closed form a·r^n1·(1 - r^(n2-n1)) / (1 - r). The factor 1 - r^k is evaluated with Expm1
+// so it keeps its precision when r is close to 1.
+func (g *GeometricCalculator) SumDifference(n1, n2 int) (float64, error) {
+	if n1 < 0 || n1 > n2 || n2 > g.n {
+		return 0, fmt.Errorf("harus memenuhi 0 <= n1 <= n2 <= %d", g.n)
+	}
+	k := float64(n2 - n1)
+	first := g.a * math.Pow(g.r, float64(n1))
+	if math.Abs(g.r-1.0) < epsilon {
+		return first * k, nil
+	}
+	if g.r > 0 {
+		return first * -math.Expm1(k*math.Log(g.r)) / (1 - g.r), nil
+	}
+	return first * (1 - math.Pow(g.r, k)) / (1 - g.r), nil
+}
+
+// PartialSumsAndProducts returns, in a single pass, the running partial sum and the natural
+// log of the running product of term magnitudes after each of the n terms. Logs keep the
+// product from overflowing; a zero term gives -Inf from then on.
+func (g *GeometricCalculator) PartialSumsAndProducts() (sums []float64, logProducts []float64) {
+	sums = make([]float64, g.n)
+	logProducts = make([]float64, g.n)
+	sum, logProduct := 0.0, 0.0
+	term := g.a
+	for i := 0; i < g.n; i++ {
+		sum += term
+		logProduct += math.Log(math.Abs(term))
+		sums[i], logProducts[i] = sum, logProduct
+		term *= g.r
+	}
+	return sums, logProducts
+}
+
+// sumState is one step of the partial-sum sequence: the partial sum and the next term
+type sumState struct {
+	sum  float64
+	term float64
+}
+
+// nextState advances the partial-sum sequence by one term
+func (g *GeometricCalculator) nextState(s sumState) sumState {
+	return sumState{sum: s.sum + s.term, term: s.term * g.r}
+}
+
+// DetectPartialSumCycle looks for a repeating cycle in the partial sums within the first n
+// steps using Floyd's tortoise-and-hare algorithm. It returns the step where the cycle starts
+// and its length; found is false when no cycle lies entirely within n steps. A term that has
+// underflowed to zero or overflowed to infinity freezes the state, which is a floating-point
+// fixed point rather than periodicity: it is reported as settled with found false.
+func (g *GeometricCalculator) DetectPartialSumCycle() (start, length int, found, settled bool) {
+	initial := sumState{term: g.a}
+	frozen := func(s sumState) bool { return s.term == 0 || math.IsInf(s.term, 0) }
+
+	// Phase 1: find a meeting point inside the cycle. The hare runs ahead, so it is the
+	// first to reach a frozen state.
+	tortoise, hare := g.nextState(initial), g.nextState(g.nextState(initial))
+	steps := 1
+	for tortoise != hare {
+		if steps > g.n {
+			return 0, 0, false, false
+		}
+		if frozen(hare) {
+			return 0, 0, false, true
+		}
+		tortoise = g.nextState(tortoise)
+		hare = g.nextState(g.nextState(hare))
+		steps++
+	}
+	if frozen(hare) {
+		return 0, 0, false, true
+	}
+
+	// Phase 2: find the first state of the cycle
+	tortoise = initial
+	for tortoise != hare {
+		tortoise = g.nextState(tortoise)
+		hare = g.nextState(hare)
+		start++
+	}
+
+	// Phase 3: measure the cycle length
+	length = 1
+	for hare = g.nextState(tortoise); tortoise != hare; hare = g.nextState(hare) {
+		length++
+	}
+	if start+length > g.n {
+		return 0, 0, false, false
+	}
+	return start, length, true, false
+}
+
+// GeometricSumRecursive calculates the sum of a geometric sequence using recursion
+func (g *GeometricCalculator) GeometricSumRecursive() float64 {
+	memo := make(map[int]float64)
+
+	var recursive func(a, r float64, n int) float64
+	recursive = func(a, r float64, n int) float64 {
+		if n == 0 {
+			return 0
+		}
+		if val, found := memo[n]; found {
+			return val
+		}
+		memo[n] = a + recursive(a*r, r, n-1)
+		return memo[n]
+	}
+
+	return recursive(g.a, g.r, g.n)
+}
+
+// GeometricSumRecursiveCounted calculates the sum exactly like GeometricSumRecursive and also
+// returns how many times the inner recursive function was invoked, including the base case
+func (g *GeometricCalculator) GeometricSumRecursiveCounted() (float64, int) {
+	memo := make(map[int]float64)
+	calls := 0
+
+	var recursive func(a, r float64, n int) float64
+	recursive = func(a, r float64, n int) float64 {
+		calls++
+		if n == 0 {
+			return 0
+		}
+		if val, found := memo[n]; found {
+			return val
+		}
+		memo[n] = a + recursive(a*r, r, n-1)
+		return memo[n]
+	}
+
+	return recursive(g.a, g.r, g.n), calls
+}
+
+// GeometricSumRecursiveTraced calculates the sum recursively like GeometricSumRecursive,
+// writing every call and its return value to w indented by recursion depth.
+// Frames deeper than maxTraceDepth are computed but not printed.
+func (g *GeometricCalculator) GeometricSumRecursiveTraced(w io.Writer) float64 {
+	var recursive func(a, r float64, n, depth int) float64
+	recursive = func(a, r float64, n, depth int) float64 {
+		indent := strings.Repeat("  ", depth)
+		traced := depth < maxTraceDepth
+		if traced {
+			fmt.Fprintf(w, "%srekursif(a=%.6g, r=%.6g, n=%d)\n", indent, a, r, n)
+		} else if depth == maxTraceDepth {
+			fmt.Fprintf(w, "%s... (%d pemanggilan berikutnya tidak ditampilkan)\n", indent, n+1)
+		}
+
+		result := 0.0
+		if n > 0 {
+			result = a + recursive(a*r, r, n-1, depth+1)
+		}
+
+		if traced {
+			fmt.Fprintf(w, "%s=> %.6g\n", indent, result)
+		}
+		return result
+	}
+
+	return recursive(g.a, g.r, g.n, 0)
+}
+
+// GeometricSumFormula calculates the sum of a geometric sequence using the closed-form formula
+func (g *GeometricCalculator) GeometricSumFormula() float64 {
+	if math.Abs(g.r-1.0) < epsilon {
+		return g.a * float64(g.n)
+	}
+	return g.a * (1 - math.Pow(g.r, float64(g.n))) / (1 - g.r)
+}
+
+// formulaWithEpsilon evaluates the closed form using eps in place of epsilon for the r = 1
+// shortcut, reporting whether the a·n shortcut was taken
+func (g *GeometricCalculator) formulaWithEpsilon(eps float64) (float64, bool) {
+	if math.Abs(g.r-1.0) < eps {
+		return g.a * float64(g.n), true
+	}
+	return g.a * (1 - math.Pow(g.r, float64(g.n))) / (1 - g.r), false
+}
+
+// SumEvenTerms calculates the sum of the terms at positions 2, 4, 6, ... using the closed form
+// of that subseries, which is geometric with first term a·r and ratio r²
+func (g *GeometricCalculator) SumEvenTerms() float64 {
+	sub := &GeometricCalculator{a: g.a * g.r, r: g.r * g.r, n: g.n / 2}
+	return sub.GeometricSumFormula()
+}
+
+// SumOddTerms calculates the sum of the terms at positions 1, 3, 5, ... using the closed form
+// of that subseries, which is geometric with first term a and ratio r²
+func (g *GeometricCalculator) SumOddTerms() float64 {
+	sub := &GeometricCalculator{a: g.a, r: g.r * g.r, n: (g.n + 1) / 2}
+	return sub.GeometricSumFormula()
+}
+
+// sumByParityIterative adds the terms at even or odd positions one by one, cross-checking
+// SumEvenTerms and SumOddTerms
+func (g *GeometricCalculator) sumByParityIterative(even bool) float64 {
+	sum := 0.0
+	term := g.a
+	for i := 1; i <= g.n; i++ {
+		if (i%2 == 0) == even {
+			sum += term
+		}
+		term *= g.r
+	}
+	return sum
+}
+
+// FormulaExpression returns the closed-form expression with the parameters substituted,
+// e.g. "S = 2·(1 - 0.5^10)/(1 - 0.5)", or "S = a·n" when r = 1
+func (g *GeometricCalculator) FormulaExpression() string {
+	a := strconv.FormatFloat(g.a, 'g', -1, 64)
+	if math.Abs(g.r-1.0) < epsilon {
+		return fmt.Sprintf("S = %s·%d", a, g.n)
+	}
+	r := strconv.FormatFloat(g.r, 'g', -1, 64)
+	if g.r < 0 {
+		r = "(" + r + ")"
+	}
+	return fmt.Sprintf("S = %s·(1 - %s^%d)/(1 - %s)", a, r, g.n, r)
+}
+
+// GeometricSumFractional evaluates the closed form a(1-r^n)/(1-r) for a real-valued n.
+// This is an analytic continuation of the formula, not a true finite sum.
+func (g *GeometricCalculator) GeometricSumFractional(nFloat float64) (float64, error) {
+	if g.r <= 0 {
+		return 0, fmt.Errorf("pangkat real r^n hanya terdefinisi untuk r > 0")
+	}
+	if nFloat < 0 || math.IsNaN(nFloat) || math.IsInf(nFloat, 0) {
+		return 0, fmt.Errorf("harap masukkan nilai n >= 0")
+	}
+	if math.Abs(g.r-1.0) < epsilon {
+		return g.a * nFloat, nil
+	}
+	return g.a * (1 - math.Pow(g.r, nFloat)) / (1 - g.r), nil
+}
+
+// GeometricIntegral calculates the continuous analog of the series, the integral of a·r^x
+// from 0 to n, which equals a·(r^n - 1)/ln(r)
+func (g *GeometricCalculator) GeometricIntegral() (float64, error) {
+	if g.r <= 0 {
+		return 0, fmt.Errorf("ln(r) tidak terdefinisi untuk r <= 0")
+	}
+	if math.Abs(g.r-1.0) < epsilon {
+		return 0, fmt.Errorf("ln(r) bernilai nol untuk r = 1")
+	}
+	return g.a * (math.Pow(g.r, float64(g.n)) - 1) / math.Log(g.r), nil
+}
+
+// TermsUntilBelow returns the smallest term position k such that a·r^(k-1) < threshold,
+// calculated analytically with logarithms. Only decaying series (0 < r < 1) qualify.
+func (g *GeometricCalculator) TermsUntilBelow(threshold float64) (int, error) {
+	if threshold <= 0 {
+		return 0, fmt.Errorf("ambang harus > 0")
+	}
+	if g.r <= 0 || g.r >= 1 {
+		return 0, fmt.Errorf("suku hanya meluruh untuk 0 < r < 1")
+	}
+	if math.Abs(g.a) < threshold {
+		return 1, nil
+	}
+
+	k := int(math.Floor(math.Log(threshold/math.Abs(g.a))/math.Log(g.r))) + 2
+
+	// Correct the logarithm's rounding at exact boundaries
+	term := func(k int) float64 { return math.Abs(g.a) * math.Pow(g.r, float64(k-1)) }
+	for k > 1 && term(k-1) < threshold {
+		k--
+	}
+	for term(k) >= threshold {
+		k++
+	}
+	return k, nil
+}
+
+// GeometricSumInfinite calculates the limit of the series as n grows without bound,
+// which only exists for |r| < 1
+func (g *GeometricCalculator) GeometricSumInfinite() (float64, error) {
+	if math.Abs(g.r) >= 1 {
+		return 0, fmt.Errorf("deret tidak konvergen untuk |r| >= 1")
+	}
+	return g.a / (1 - g.r), nil
+}
+
+// PercentOfLimit returns the finite n-term sum as a percentage of the infinite sum,
+// which only exists for convergent series
+func (g *GeometricCalculator) PercentOfLimit() (float64, error) {
+	infinite, err := g.GeometricSumInfinite()
+	if err != nil {
+		return 0, err
+	}
+	return g.GeometricSumFormula() / infinite * 100, nil
+}
+
+// MaxRatioForConvergence finds by bisection the largest r in (0, 1) for which the n-term sum
+// with first term a is within tol of the infinite limit, i.e. a·r^n/(1-r) <= tol
+func (g *GeometricCalculator) MaxRatioForConvergence(n int, tol float64) (float64, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf("harap masukkan nilai n > 0")
+	}
+	if tol <= 0 {
+		return 0, fmt.Errorf("toleransi harus > 0")
+	}
+
+	truncation := func(r float64) float64 {
+		return math.Abs(g.a) * math.Pow(r, float64(n)) / (1 - r)
+	}
+
+	// The truncation error grows monotonically with r on (0, 1)
+	lo, hi := 0.0, 1.0
+	for i := 0; i < 200 && hi-lo > machineEpsilon; i++ {
+		mid := (lo + hi) / 2
+		if truncation(mid) <= tol {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	if lo == 0 {
+		return 0, fmt.Errorf("tidak ada rasio dalam (0, 1) yang memenuhi toleransi %g", tol)
+	}
+	return lo, nil
+}
+
+// tailNegligible reports whether the terms beyond n are below float64 precision
+// relative to the infinite sum, making the finite and infinite sums indistinguishable
+func (g *GeometricCalculator) tailNegligible() bool {
+	infinite, err := g.GeometricSumInfinite()
+	if err != nil {
+		return false
+	}
+	tail := g.a * math.Pow(g.r, float64(g.n)) / (1 - g.r)
+	return math.Abs(tail) < machineEpsilon*math.Abs(infinite)
+}
+
+// WeightedSum calculates Σ i·term_i over the term positions i = 1..n using its closed form
+func (g *GeometricCalculator) WeightedSum() float64 {
+	n := float64(g.n)
+	if math.Abs(g.r-1.0) < epsilon {
+		return g.a * n * (n + 1) / 2
+	}
+	rn := math.Pow(g.r, n)
+	return g.a * (1 - (n+1)*rn + n*rn*g.r) / ((1 - g.r) * (1 - g.r))
+}
+
+// CentroidIndex calculates the weighted average position Σ i·term_i / Σ term_i of the terms
+func (g *GeometricCalculator) CentroidIndex() (float64, error) {
+	sum := g.GeometricSumFormula()
+	if math.Abs(sum) < epsilon {
+		return 0, fmt.Errorf("jumlah deret nol, titik berat tidak terdefinisi")
+	}
+	return g.WeightedSum() / sum, nil
+}
+
+// MaxTerm finds the term of largest magnitude and its 1-based position in a single pass over
+// the terms. Ties keep the earliest position.
+func (g *GeometricCalculator) MaxTerm() (float64, int) {
+	maxValue, maxIndex := g.a, 1
+	term := g.a
+	for i := 2; i <= g.n; i++ {
+		term *= g.r
+		if math.Abs(term) > math.Abs(maxValue) {
+			maxValue, maxIndex = term, i
+		}
+	}
+	return maxValue, maxIndex
+}
+
+// SumWithNeighbors returns the formula sum together with the float64 values immediately
+// below and above it, showing the spacing of representable values at the sum's magnitude
+func (g *GeometricCalculator) SumWithNeighbors() (prev, value, next float64) {
+	value = g.GeometricSumFormula()
+	return math.Nextafter(value, math.Inf(-1)), value, math.Nextafter(value, math.Inf(1))
+}
+
+// GeometricDistributionMean treats r as the per-trial failure probability of a geometric
+// distribution P(X = i) = (1-r)·r^(i-1) and returns its mean Σ i·(1-r)·r^(i-1) = 1/(1-r).
+// The first term a plays no role.
+func (g *GeometricCalculator) GeometricDistributionMean() (float64, error) {
+	if g.r <= 0 || g.r >= 1 {
+		return 0, fmt.Errorf("r harus berupa peluang 0 < r < 1")
+	}
+	return 1 / (1 - g.r), nil
+}
+
+// GeometricDistributionMeanTruncated returns the partial expectation Σ i·(1-r)·r^(i-1) over
+// i = 1..n, the weighted sum of a series with first term 1-r
+func (g *GeometricCalculator) GeometricDistributionMeanTruncated() (float64, error) {
+	if g.r <= 0 || g.r >= 1 {
+		return 0, fmt.Errorf("r harus berupa peluang 0 < r < 1")
+	}
+	pmf := &GeometricCalculator{a: 1 - g.r, r: g.r, n: g.n}
+	return pmf.WeightedSum(), nil
+}
+
+// BestBudgetWindow finds the B consecutive terms whose sum is closest to the full sum and
+// returns the 1-based position of the first of them with that window's sum. Each window is
+// evaluated with the closed-form range sum. For B outside 1..n it returns start 0.
+func (g *GeometricCalculator) BestBudgetWindow(B int) (start int, approxSum float64) {
+	if B < 1 || B > g.n {
+		return 0, 0
+	}
+	full := g.GeometricSumFormula()
+	bestErr := math.Inf(1)
+	for i := 1; i+B-1 <= g.n; i++ {
+		sum := g.closedRangeSum(i, i+B-1)
+		if err := math.Abs(full - sum); err < bestErr {
+			start, approxSum, bestErr = i, sum, err
+		}
+	}
+	return start, approxSum
+}
+
+// SumDerivativeWrtR calculates the analytic derivative of the sum with respect to the ratio r
+func (g *GeometricCalculator) SumDerivativeWrtR() float64 {
+	n := float64(g.n)
+	if math.Abs(g.r-1.0) < epsilon {
+		return g.a * n * (n - 1) / 2
+	}
+	rn1 := math.Pow(g.r, n-1)
+	return g.a * (1 - g.r*rn1 - n*rn1*(1-g.r)) / ((1 - g.r) * (1 - g.r))
+}
+
+// sumDerivativeFiniteDiff approximates dS/dr with a central difference of step h,
+// used to cross-check SumDerivativeWrtR
+func (g *GeometricCalculator) sumDerivativeFiniteDiff(h float64) float64 {
+	upper := &GeometricCalculator{a: g.a, r: g.r + h, n: g.n}
+	lower := &GeometricCalculator{a: g.a, r: g.r - h, n: g.n}
+	return (upper.GeometricSumFormula() - lower.GeometricSumFormula()) / (2 * h)
+}
+
+// checkParams verifies that the parameters describe a valid geometric sequence
+func checkParams(a, r float64, n int) error {
+	if a <= 0 {
+		return fmt.Errorf("harap masukkan nilai a > 0")
+	}
+	if r <= 0 {
+		return fmt.Errorf("harap masukkan nilai r > 0")
+	}
+	if n <= 0 {
+		return fmt.Errorf("harap masukkan nilai n > 0")
+	}
+	return nil
+}
+
+// prompt prints a prompt label unless running in quiet mode
+func prompt(quiet bool, label string) {
+	if !quiet {
+		fmt.Print(label)
+	}
+}
+
+// seriesPrompt describes which values of a, r and n a program accepts and how it asks for them
+type seriesPrompt struct {
+	SignedA bool   // Terima a negatif (syarat a != 0, bukan a > 0)
+	SignedR bool   // Terima r berapa pun, termasuk nol dan negatif (bukan hanya r > 0)
+	RLabel  string // Label prompt r; kosong berarti label bawaan
+	NLabel  string // Label prompt n; kosong berarti "Jumlah suku (n): "
+}
+
+// readA prompts for the first term a and checks it against the sign constraint
+func (p seriesPrompt) readA(in *lineReader, quiet bool) (float64, error) {
+	var a float64
+	prompt(quiet, "Suku pertama (a): ")
+	err := in.readAmount(&a)
+	if p.SignedA && (err != nil || a == 0) {
+		return 0, fmt.Errorf("harap masukkan nilai a != 0")
+	}
+	if !p.SignedA && (err != nil || a <= 0) {
+		return 0, fmt.Errorf("harap masukkan nilai a > 0")
+	}
+	return a, nil
+}
+
+// readR prompts for the ratio r and checks it against the sign constraint
+func (p seriesPrompt) readR(in *lineReader, quiet bool) (float64, error) {
+	label := p.RLabel
+	if label == "" {
+		label = "Rasio (r): "
+		if p.SignedR {
+			label = "Rasio (r, boleh negatif): "
+		}
+	}
+	var r float64
+	prompt(quiet, label)
+	err := in.readFloat(&r)
+	if p.SignedR && err != nil {
+		return 0, fmt.Errorf("harap masukkan nilai r yang valid")
+	}
+	if !p.SignedR && (err != nil || r <= 0) {
+		return 0, fmt.Errorf("harap masukkan nilai r > 0")
+	}
+	return r, nil
+}
+
+// readN prompts for the term count n, which must be positive
+func (p seriesPrompt) readN(in *lineReader, quiet bool) (int, error) {
+	label := p.NLabel
+	if label == "" {
+		label = "Jumlah suku (n): "
+	}
+	var n int
+	prompt(quiet, label)
+	if err := in.readInt(&n); err != nil || n <= 0 {
+		return 0, fmt.Errorf("harap masukkan nilai n > 0")
+	}
+	return n, nil
+}
+
+// read prompts for a, r and n in that order, stopping at the first invalid value
+func (p seriesPrompt) read(in *lineReader, quiet bool) (float64, float64, int, error) {
+	a, err := p.readA(in, quiet)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	r, err := p.readR(in, quiet)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	n, err := p.readN(in, quiet)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return a, r, n, nil
+}
+
+// signedSeries accepts any nonzero a and any r, for programs that handle alternating series
+var signedSeries = seriesPrompt{SignedA: true, SignedR: true}
+
+// validateInput prompts the user to input valid parameters for the geometric sequence,
+// reading one value per line from in. In quiet mode no prompts are printed.
+func validateInput(in *lineReader, quiet bool) (float64, float64, int, error) {
+	return seriesPrompt{}.read(in, quiet)
+}
+
+// BenchmarkConfig holds the tunable settings of the comparison benchmark
+type BenchmarkConfig struct {
+	Runs              int  // Jumlah pengujian untuk perbandingan
+	WarmUpRuns        int  // Jumlah iterasi pemanasan (warm-up)
+	Iterations        int  // Jumlah iterasi untuk pengukuran waktu
+	MaxRecursionDepth int  // Batas n untuk tolok ukur rekursif (pelindung stack overflow)
+	SubtractOverhead  bool // Kurangkan overhead pengukuran dari waktu yang dilaporkan
+}
+
+// DefaultBenchmarkConfig returns the benchmark settings used when no flags override them
+func DefaultBenchmarkConfig() BenchmarkConfig {
+	return BenchmarkConfig{
+		Runs:              numRuns,
+		WarmUpRuns:        warmUpRuns,
+		Iterations:        iterations,
+		MaxRecursionDepth: defaultMaxRecursionDepth,
+	}
+}
+
+// averageTime runs the measurement c.Runs times with the configured warm-up and iteration
+// counts and returns the mean in nanoseconds
+func (c BenchmarkConfig) averageTime(f func()) float64 {
+	total := 0.0
+	for i := 0; i < c.Runs; i++ {
+		total += measureExecutionTimeWith(f, c.WarmUpRuns, c.Iterations)
+	}
+	return total / float64(c.Runs)
+}
+
+// Result holds the parameters and outcome of one comparison run
+type Result struct {
+	A                 float64   `json:"a"`                   // Suku pertama
+	R                 float64   `json:"r"`                   // Rasio
+	N                 int       `json:"n"`                   // Jumlah suku
+	Iterative         float64   `json:"iterative"`           // Hasil metode iteratif
+	Recursive         float64   `json:"recursive"`           // Hasil metode rekursif
+	Formula           float64   `json:"formula"`             // Hasil rumus tertutup
+	IterativeTime     float64   `json:"iterative_ns"`        // Rata-rata waktu iteratif (ns)
+	RecursiveTime     float64   `json:"recursive_ns"`        // Rata-rata waktu rekursif (ns)
+	FormulaTime       float64   `json:"formula_ns"`          // Rata-rata waktu rumus tertutup (ns)
+	RecursiveSkipped  bool      `json:"recursive_skipped"`   // Tolok ukur rekursif dilewati karena n melebihi batas
+	MaxRecursionDepth int       `json:"max_recursion_depth"` // Batas kedalaman rekursi yang berlaku
+	Overhead          float64   `json:"overhead_ns"`         // Overhead pengukuran fungsi kosong (ns)
+	OverheadApplied   bool      `json:"overhead_subtracted"` // Overhead sudah dikurangkan dari waktu
+	Timestamp         time.Time `json:"timestamp"`           // Waktu pengujian
+}
+
+// overheadKey identifies the timing settings an overhead calibration was measured with
+type overheadKey struct {
+	runs, warmUp, iters int
+}
+
+var (
+	overheadMu    sync.Mutex
+	overheadCache = map[overheadKey]float64{}
+)
+
+// calibrateOverhead measures the cost of timing an empty function through the benchmark
+// harness with c's run, warm-up and iteration counts, so it can be subtracted from timings
+// taken with the same settings. Each setting is measured once and then served from the cache.
+func (c BenchmarkConfig) calibrateOverhead() float64 {
+	key := overheadKey{runs: c.Runs, warmUp: c.WarmUpRuns, iters: c.Iterations}
+	overheadMu.Lock()
+	defer overheadMu.Unlock()
+	if overhead, ok := overheadCache[key]; ok {
+		return overhead
+	}
+	overhead := c.averageTime(func() {})
+	overheadCache[key] = overhead
+	return overhead
+}
+
+// subtractOverhead removes the harness overhead from t without going below zero
+func subtractOverhead(t, overhead float64) float64 {
+	return math.Max(t-overhead, 0)
+}
+
+// averageTime runs the measurement with DefaultBenchmarkConfig and returns the mean in nanoseconds
+func averageTime(f func()) float64 {
+	return DefaultBenchmarkConfig().averageTime(f)
+}
+
+// runComparison benchmarks the iterative, recursive and formula methods and collects the results.
+// The recursive method is skipped when n exceeds cfg.MaxRecursionDepth.
+func runComparison(calc *GeometricCalculator, cfg BenchmarkConfig) Result {
+	return runComparisonWith(calc, cfg, cfg.calibrateOverhead)
+}
+
+// runComparisonWith is runComparison taking the harness overhead from overhead, which is
+// called right after the methods are measured and so runs under the same conditions
+func runComparisonWith(calc *GeometricCalculator, cfg BenchmarkConfig, overhead func() float64) Result {
+	res := Result{
+		A:                 calc.a,
+		R:                 calc.r,
+		N:                 calc.n,
+		MaxRecursionDepth: cfg.MaxRecursionDepth,
+	}
+
+	res.IterativeTime = cfg.averageTime(func() {
+		res.Iterative = calc.GeometricSumIterative()
+	})
+
+	if calc.n > cfg.MaxRecursionDepth {
+		res.RecursiveSkipped = true
+	} else {
+		res.RecursiveTime = cfg.averageTime(func() {
+			res.Recursive = calc.GeometricSumRecursive()
+		})
+	}
+
+	res.FormulaTime = cfg.averageTime(func() {
+		res.Formula = calc.GeometricSumFormula()
+	})
+
+	res.Overhead = overhead()
+	if cfg.SubtractOverhead {
+		res.IterativeTime = subtractOverhead(res.IterativeTime, res.Overhead)
+		res.RecursiveTime = subtractOverhead(res.RecursiveTime, res.Overhead)
+		res.FormulaTime = subtractOverhead(res.FormulaTime, res.Overhead)
+		res.OverheadApplied = true
+	}
+
+	res.Timestamp = time.Now()
+	return res
+}
+
+// printComparison displays the results of a comparison run, formatting numbers per disp
+func printComparison(res Result, disp displayOptions) {
+	fmt.Println("\n=== Hasil Perbandingan ===")
+	fmt.Printf("Iteratif: %s (waktu: %s ns)\n", disp.amount(res.Iterative, 3), disp.number(res.IterativeTime, 3))
+	if res.RecursiveSkipped {
+		fmt.Printf("Rekursif: dilewati (n=%d melebihi batas kedalaman rekursi %d)\n", res.N, res.MaxRecursionDepth)
+	} else {
+		fmt.Printf("Rekursif: %s (waktu: %s ns)\n", disp.amount(res.Recursive, 3), disp.number(res.RecursiveTime, 3))
+	}
+	fmt.Printf("Hasil: %s (waktu rumus: %s ns)\n", disp.amount(res.Formula, 2), disp.number(res.FormulaTime, 3))
+	if res.OverheadApplied {
+		fmt.Printf("Overhead pengukuran: %s ns (sudah dikurangkan dari waktu di atas)\n", disp.number(res.Overhead, 3))
+	} else {
+		fmt.Printf("Overhead pengukuran: %s ns (termasuk dalam waktu di atas, gunakan -subtract-overhead)\n", disp.number(res.Overhead, 3))
+	}
+
+	if res.RecursiveSkipped {
+		fmt.Println("\nHanya metode iteratif dan rumus yang diukur.")
+		fmt.Println("Gunakan -max-depth untuk menaikkan batas kedalaman rekursi.")
+		return
+	}
+
+	// Performance ratio
+	if res.IterativeTime > 0 {
+		ratio := res.RecursiveTime / res.IterativeTime
+		fmt.Printf("\nPerbandingan waktu (Rekursif/Iteratif): %sx\n", disp.number(ratio, 2))
+		if ratio > 1 {
+			fmt.Printf("Metode iteratif lebih cepat sebesar %s%%\n", disp.number((ratio-1)*100, 2))
+		} else {
+			fmt.Printf("Metode rekursif lebih cepat sebesar %s%%\n", disp.number((1-ratio)*100, 2))
+		}
+	}
+}
+
+// OneLineSummary formats a comparison result as a single grep-friendly line with the
+// parameters, the timing of every method and the fastest method
+func OneLineSummary(r Result) string {
+	winner, best := "iter", r.IterativeTime
+	rec, ratio := "skipped", "n/a"
+	if !r.RecursiveSkipped {
+		rec = fmt.Sprintf("%.1fns", r.RecursiveTime)
+		if r.IterativeTime > 0 {
+			ratio = fmt.Sprintf("%.2fx", r.RecursiveTime/r.IterativeTime)
+		}
+		if r.RecursiveTime < best {
+			winner, best = "rec", r.RecursiveTime
+		}
+	}
+	if r.FormulaTime < best {
+		winner = "formula"
+	}
+	return fmt.Sprintf("a=%g r=%g n=%d | iter=%.1fns rec=%s formula=%.1fns ratio=%s winner=%s",
+		r.A, r.R, r.N, r.IterativeTime, rec, r.FormulaTime, ratio, winner)
+}
+
+// ComparisonProgram runs the comparison between iterative and recursive methods,
+// storing the result in db when it is not nil
+func ComparisonProgram(db *sql.DB, cfg BenchmarkConfig, disp displayOptions) {
+	fmt.Println("\n=== Perbandingan Metode ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	compareAndReport(&GeometricCalculator{a: a, r: r, n: n}, db, cfg, disp)
+}
+
+// compareAndReport benchmarks calc, prints the comparison and stores it in db when it is not nil.
+// The collected result is returned for further export.
+func compareAndReport(calc *GeometricCalculator, db *sql.DB, cfg BenchmarkConfig, disp displayOptions) Result {
+	res := runComparison(calc, cfg)
+	printComparison(res, disp)
+
+	// The assessment makes three extra O(n) passes, so it only runs when asked for
+	if disp.Reliability {
+		if _, reliability, err := calc.ComputeSum(); err == nil {
+			fmt.Printf("Keandalan hasil: %s\n", reliability)
+		}
+	}
+
+	if percent, err := calc.PercentOfLimit(); err == nil {
+		fmt.Printf("\nJumlah %d suku mencakup %s%% dari jumlah tak hingga\n", calc.n, disp.number(percent, 4))
+	}
+
+	if calc.tailNegligible() {
+		infinite, _ := calc.GeometricSumInfinite()
+		fmt.Println("\nSaran: gunakan jumlah tak hingga, suku ekor dapat diabaikan")
+		fmt.Printf("Jumlah tak hingga: %s\n", disp.amount(infinite, 3))
+	}
+
+	if db != nil {
+		if err := InsertResult(db, res); err != nil {
+			fmt.Printf("Error: gagal menyimpan ke database: %v\n", err)
+		} else {
+			fmt.Println("Hasil disimpan ke database.")
+		}
+	}
+	return res
+}
+
+// TraceProgram prints the recursion frames of the recursive method, refusing n beyond
+// cfg.MaxRecursionDepth
+func TraceProgram(cfg BenchmarkConfig) {
+	fmt.Println("\n=== Jejak Rekursi ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if n > cfg.MaxRecursionDepth {
+		fmt.Printf("Error: n=%d melebihi batas kedalaman rekursi %d\n", n, cfg.MaxRecursionDepth)
+		fmt.Println("Gunakan -max-depth untuk menaikkan batas kedalaman rekursi.")
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+
+	fmt.Println()
+	result := calc.GeometricSumRecursiveTraced(os.Stdout)
+	_, calls := calc.GeometricSumRecursiveCounted()
+	fmt.Printf("\nHasil: %.3f\n", result)
+	fmt.Printf("jumlah pemanggilan rekursif: %d (n=%d)\n", calls, n)
+}
+
+// SensitivityProgram displays the sensitivity of the sum to the ratio r
+func SensitivityProgram() {
+	fmt.Println("\n=== Sensitivitas Jumlah terhadap Rasio ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	derivative := calc.SumDerivativeWrtR()
+
+	fmt.Printf("\nHasil: %.3f\n", calc.GeometricSumFormula())
+	fmt.Printf("dS/dr: %.6g\n", derivative)
+	fmt.Printf("dS/dr (selisih hingga): %.6g\n", calc.sumDerivativeFiniteDiff(1e-6))
+	fmt.Printf("Perubahan r sebesar 0.01 mengubah jumlah sekitar %.6g\n", derivative*0.01)
+}
+
+// EffectiveCountProgram displays how many terms contributed meaningfully to the sum
+func EffectiveCountProgram() {
+	fmt.Println("\n=== Jumlah Suku Efektif ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var relTol float64
+	fmt.Print("Toleransi relatif (mis. 1e-9): ")
+	if err := stdin.readFloat(&relTol); err != nil || relTol <= 0 {
+		fmt.Println("Error: harap masukkan toleransi > 0")
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	sum, effectiveN := calc.SumWithEffectiveCount(relTol)
+
+	fmt.Printf("\nHasil: %.3f\n", sum)
+	fmt.Printf("Suku efektif: %d dari %d\n", effectiveN, n)
+	if effectiveN < n {
+		fmt.Printf("%d suku terakhir masing-masing menyumbang kurang dari %g jumlah berjalan\n", n-effectiveN, relTol)
+	}
+}
+
+// parseSweep parses a "start:end:step" range specification
+func parseSweep(spec string) (start, end, step float64, err error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) != 3 {
+		return 0, 0, 0, fmt.Errorf("format rentang harus start:end:step, diterima %q", spec)
+	}
+	values := make([]float64, 3)
+	for i, part := range parts {
+		values[i], err = strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("nilai rentang tidak valid %q", part)
+		}
+	}
+	start, end, step = values[0], values[1], values[2]
+	if step <= 0 || end < start {
+		return 0, 0, 0, fmt.Errorf("rentang harus memenuhi start <= end dan step > 0")
+	}
+	return start, end, step, nil
+}
+
+// RatioSweepProgram prints the sum for every ratio in the range spec with a and n fixed,
+// as an aligned table, CSV or TSV
+func RatioSweepProgram(a float64, n int, spec, format string) error {
+	start, end, step, err := parseSweep(spec)
+	if err != nil {
+		return err
+	}
+
+	sep := ""
+	switch format {
+	case "table":
+	case "csv":
+		sep = ","
+	case "tsv":
+		sep = "\t"
+	default:
+		return fmt.Errorf("format tidak dikenal: %s (gunakan table, csv, atau tsv)", format)
+	}
+
+	// The ratios only grow from start, so validating start covers the whole sweep and no
+	// output is written for an invalid one
+	if err := checkParams(a, start, n); err != nil {
+		return err
+	}
+
+	if sep != "" {
+		fmt.Printf("r%ssum\n", sep)
+	} else {
+		fmt.Printf("%-12s %s\n", "r", "Jumlah")
+	}
+
+	// Compute each ratio from its index to avoid accumulating rounding error in r
+	steps := int(math.Floor((end-start)/step + 1e-9))
+	for i := 0; i <= steps; i++ {
+		r := start + float64(i)*step
+		calc := &GeometricCalculator{a: a, r: r, n: n}
+		if sep != "" {
+			fmt.Printf("%.10g%s%g\n", r, sep, calc.GeometricSumFormula())
+		} else {
+			fmt.Printf("%-12.6g %.6f\n", r, calc.GeometricSumFormula())
+		}
+	}
+	return nil
+}
+
+// FractionalProgram evaluates the closed form for a fractional number of terms
+func FractionalProgram() {
+	fmt.Println("\n=== Jumlah dengan n Pecahan (Kontinuasi Analitik) ===")
+	spec := seriesPrompt{SignedR: true, RLabel: "Rasio (r): "}
+	a, err := spec.readA(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	r, err := spec.readR(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var nFloat float64
+	fmt.Print("Jumlah suku pecahan (n, mis. 2.5): ")
+	if err := stdin.readFloat(&nFloat); err != nil {
+		fmt.Println("Error: harap masukkan nilai n yang valid")
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r}
+	result, err := calc.GeometricSumFractional(nFloat)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\nHasil: %.6f\n", result)
+	fmt.Println("Catatan: ini kontinuasi analitik dari rumus, bukan jumlah suku yang sebenarnya.")
+}
+
+// AccuracyStudyProgram runs the Monte Carlo accuracy comparison over random series
+func AccuracyStudyProgram() {
+	fmt.Println("\n=== Studi Akurasi Monte Carlo ===")
+	var trials int
+	var seed int64
+
+	fmt.Print("Jumlah deret acak (K): ")
+	if err := stdin.readInt(&trials); err != nil || trials <= 0 {
+		fmt.Println("Error: harap masukkan nilai K > 0")
+		return
+	}
+
+	fmt.Print("Seed RNG: ")
+	if err := stdin.readInt64(&seed); err != nil {
+		fmt.Println("Error: harap masukkan seed bilangan bulat")
+		return
+	}
+
+	report := runAccuracyStudy(trials, seed)
+
+	fmt.Printf("\n%-12s %-16s %s\n", "Metode", "Rata-rata galat", "Galat maks")
+	for _, m := range report {
+		fmt.Printf("%-12s %-16.3e %.3e\n", m.name, m.mean, m.max)
+	}
+}
+
+// IntegralProgram compares the discrete sum with its continuous integral analog
+func IntegralProgram() {
+	fmt.Println("\n=== Perbandingan Jumlah Diskrit dan Integral Kontinu ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	integral, err := calc.GeometricIntegral()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	sum := calc.GeometricSumFormula()
+
+	fmt.Printf("\nJumlah diskrit: %.6f\n", sum)
+	fmt.Printf("Integral kontinu: %.6f\n", integral)
+	fmt.Printf("Selisih: %.6f\n", sum-integral)
+	if integral != 0 {
+		fmt.Printf("Rasio (diskrit/integral): %.6f\n", sum/integral)
+	}
+}
+
+// ExpressionProgram displays the closed-form expression with the user's values substituted
+func ExpressionProgram() {
+	fmt.Println("\n=== Bentuk Tertutup Simbolik ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	fmt.Printf("\n%s\n", calc.FormulaExpression())
+	fmt.Printf("  = %.6f\n", calc.GeometricSumFormula())
+}
+
+// FormulaSweepProgram shows how the formula's speed and accuracy change as n grows
+func FormulaSweepProgram() {
+	fmt.Println("\n=== Kecepatan dan Akurasi Rumus terhadap n ===")
+	var spec seriesPrompt
+	a, err := spec.readA(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	r, err := spec.readR(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\n%-10s %-14s %s\n", "n", "Waktu (ns)", "Galat relatif")
+	for _, row := range runFormulaSweep(a, r) {
+		fmt.Printf("%-10d %-14.3f %.3e\n", row.n, row.timeNs, row.relError)
+	}
+}
+
+// CentroidProgram displays the weighted average index of the terms
+func CentroidProgram() {
+	fmt.Println("\n=== Titik Berat Indeks Suku ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	centroid, err := calc.CentroidIndex()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\nJumlah berbobot (Σ i·suku): %.6f\n", calc.WeightedSum())
+	fmt.Printf("Jumlah (Σ suku): %.6f\n", calc.GeometricSumFormula())
+	fmt.Printf("Titik berat indeks: %.4f (dari suku 1 sampai %d)\n", centroid, n)
+}
+
+// ValidationBenchmarkProgram compares the cost of parsing canned input with the cost of
+// the computation itself
+func ValidationBenchmarkProgram() {
+	fmt.Println("\n=== Biaya Validasi Input ===")
+	const canned = "2\n0.5\n10\n"
+
+	lineReaderTime := averageTime(func() {
+		validateInput(newLineReader(strings.NewReader(canned)), true)
+	})
+
+	fscanTime := averageTime(func() {
+		var a, r float64
+		var n int
+		fmt.Fscan(strings.NewReader(canned), &a, &r, &n)
+	})
+
+	calc := &GeometricCalculator{a: 2, r: 0.5, n: 10}
+	iterativeTime := averageTime(func() {
+		calc.GeometricSumIterative()
+	})
+	formulaTime := averageTime(func() {
+		calc.GeometricSumFormula()
+	})
+
+	fmt.Printf("\nInput tetap: a=2, r=0.5, n=10\n")
+	fmt.Printf("validateInput (bufio): %.3f ns\n", lineReaderTime)
+	fmt.Printf("fmt.Fscan:             %.3f ns\n", fscanTime)
+	fmt.Printf("Iteratif:              %.3f ns\n", iterativeTime)
+	fmt.Printf("Rumus:                 %.3f ns\n", formulaTime)
+	if iterativeTime > 0 {
+		fmt.Printf("\nValidasi setara %.2fx waktu metode iteratif\n", lineReaderTime/iterativeTime)
+	}
+}
+
+// TermDecayProgram displays the first term position that falls below a threshold
+func TermDecayProgram() {
+	fmt.Println("\n=== Peluruhan Suku ===")
+	spec := seriesPrompt{SignedR: true, RLabel: "Rasio (0 < r < 1): "}
+	a, err := spec.readA(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	r, err := spec.readR(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var threshold float64
+	fmt.Print("Ambang batas suku: ")
+	if err := stdin.readFloat(&threshold); err != nil {
+		fmt.Println("Error: harap masukkan ambang yang valid")
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r}
+	k, err := calc.TermsUntilBelow(threshold)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\nSuku ke-%d adalah suku pertama di bawah %g (nilai: %.6g)\n", k, threshold, a*math.Pow(r, float64(k-1)))
+}
+
+// HybridProgram compares the float32/float64 hybrid accumulation with the float64 iterative sum
+func HybridProgram() {
+	fmt.Println("\n=== Akumulasi Hibrida float32/float64 ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var foldEvery int
+	fmt.Print("Lipat ke float64 setiap K suku: ")
+	if err := stdin.readInt(&foldEvery); err != nil || foldEvery <= 0 {
+		fmt.Println("Error: harap masukkan nilai K > 0")
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	ref := calc.GeometricSumBig(referencePrec)
+
+	var iterative, hybrid float64
+	iterativeTime := averageTime(func() {
+		iterative = calc.GeometricSumIterative()
+	})
+	hybridTime := averageTime(func() {
+		hybrid = calc.GeometricSumHybrid(foldEvery)
+	})
+
+	fmt.Printf("\n%-10s %-20s %-14s %s\n", "Metode", "Hasil", "Waktu (ns)", "Galat relatif")
+	fmt.Printf("%-10s %-20.10f %-14.3f %.3e\n", "float64", iterative, iterativeTime, relativeError(iterative, ref))
+	fmt.Printf("%-10s %-20.10f %-14.3f %.3e\n", "Hibrida", hybrid, hybridTime, relativeError(hybrid, ref))
+}
+
+// EpsilonProgram shows how the choice of epsilon changes the formula result for r close to 1
+func EpsilonProgram() {
+	fmt.Println("\n=== Pengaruh Epsilon di Sekitar r = 1 ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	ref := calc.GeometricSumBig(referencePrec)
+	refValue, _ := ref.Float64()
+	general, _ := calc.formulaWithEpsilon(0)
+
+	fmt.Printf("\n|r - 1| = %.3e\n", math.Abs(r-1))
+	fmt.Printf("Jalan pintas a·n: %.12f\n", a*float64(n))
+	fmt.Printf("Rumus umum:       %.12f\n", general)
+	fmt.Printf("Acuan big.Float:  %.12f\n", refValue)
+
+	fmt.Printf("\n%-10s %-14s %-22s %s\n", "Epsilon", "Cabang", "Hasil", "Galat relatif")
+	for _, eps := range []float64{1e-4, 1e-6, 1e-8, 1e-10, 1e-12, 1e-14} {
+		result, shortcut := calc.formulaWithEpsilon(eps)
+		branch := "rumus umum"
+		if shortcut {
+			branch = "a·n"
+		}
+		marker := ""
+		if eps == epsilon {
+			marker = " (dipakai)"
+		}
+		fmt.Printf("%-10.0e %-14s %-22.12f %.3e%s\n", eps, branch, result, relativeError(result, ref), marker)
+	}
+}
+
+// SubdividedProgram compares the sum with intra-period compounding against the plain sum
+func SubdividedProgram() {
+	fmt.Println("\n=== Pemajemukan dalam Periode ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var m int
+	fmt.Print("Jumlah sub-langkah per suku (m): ")
+	if err := stdin.readInt(&m); err != nil || m <= 0 {
+		fmt.Println("Error: harap masukkan nilai m > 0")
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	plain := calc.GeometricSumFormula()
+	subdivided := calc.SubdividedSum(m)
+
+	fmt.Printf("\nRasio per sub-langkah: %.10g\n", math.Pow(r, 1/float64(m)))
+	fmt.Printf("Jumlah tanpa subdivisi: %.6f\n", plain)
+	fmt.Printf("Jumlah dengan %d sub-langkah (%d sub-suku): %.6f\n", m, n*m, subdivided)
+	fmt.Printf("Selisih: %.6f\n", subdivided-plain)
+}
+
+// MaxRatioProgram finds the largest ratio for which n terms are as good as infinitely many
+func MaxRatioProgram() {
+	fmt.Println("\n=== Rasio Maksimum untuk Konvergensi ===")
+	var spec seriesPrompt
+	a, err := spec.readA(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	n, err := spec.readN(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var tol float64
+	fmt.Print("Toleransi terhadap jumlah tak hingga: ")
+	if err := stdin.readFloat(&tol); err != nil {
+		fmt.Println("Error: harap masukkan toleransi yang valid")
+		return
+	}
+
+	calc := &GeometricCalculator{a: a}
+	r, err := calc.MaxRatioForConvergence(n, tol)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc.r, calc.n = r, n
+	infinite, _ := calc.GeometricSumInfinite()
+	fmt.Printf("\nRasio maksimum: %.10f\n", r)
+	fmt.Printf("Jumlah %d suku: %.10f\n", n, calc.GeometricSumFormula())
+	fmt.Printf("Jumlah tak hingga: %.10f\n", infinite)
+}
+
+// PartialSumProgram answers repeated partial-sum queries from the cached prefix sums
+func PartialSumProgram() {
+	fmt.Println("\n=== Jumlah Parsial ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	for {
+		fmt.Printf("\nJumlah k suku pertama (0-%d, kosong untuk selesai): ", n)
+		line, err := stdin.readLine()
+		if err != nil || line == "" {
+			return
+		}
+		k, err := strconv.Atoi(line)
+		if err != nil {
+			fmt.Println("Error: harap masukkan bilangan bulat")
+			continue
+		}
+		sum, err := calc.PartialSumTo(k)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			continue
+		}
+		fmt.Printf("S_%d = %.6f\n", k, sum)
+	}
+}
+
+// IntervalProgram displays the guaranteed enclosure of the sum from interval arithmetic
+func IntervalProgram() {
+	fmt.Println("\n=== Aritmetika Interval ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	lo, hi := calc.GeometricSumInterval()
+	formula := calc.GeometricSumFormula()
+
+	fmt.Printf("\nBatas bawah: %.17g\n", lo)
+	fmt.Printf("Batas atas:  %.17g\n", hi)
+	fmt.Printf("Lebar interval: %.3e\n", hi-lo)
+	if lo <= formula && formula <= hi {
+		fmt.Printf("Hasil rumus %.17g berada di dalam interval\n", formula)
+	} else {
+		fmt.Printf("Hasil rumus %.17g berada di luar interval\n", formula)
+	}
+}
+
+// ParityProgram splits the sum into its even- and odd-positioned subseries
+func ParityProgram() {
+	fmt.Println("\n=== Suku Genap dan Ganjil ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	even, odd := calc.SumEvenTerms(), calc.SumOddTerms()
+
+	fmt.Printf("\n%-8s %-20s %s\n", "Suku", "Rumus", "Iteratif")
+	fmt.Printf("%-8s %-20.10f %.10f\n", "Genap", even, calc.sumByParityIterative(true))
+	fmt.Printf("%-8s %-20.10f %.10f\n", "Ganjil", odd, calc.sumByParityIterative(false))
+	fmt.Printf("\nGenap + ganjil: %.10f\n", even+odd)
+	fmt.Printf("Jumlah total:   %.10f\n", calc.GeometricSumFormula())
+}
+
+// AlternatingRatioProgram computes the sum of a series whose steps alternate between two ratios
+func AlternatingRatioProgram() {
+	fmt.Println("\n=== Deret dengan Dua Rasio Bergantian ===")
+	var spec seriesPrompt
+	a, err := spec.readA(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var r1, r2 float64
+	fmt.Print("Rasio langkah ganjil (r1): ")
+	if err := stdin.readFloat(&r1); err != nil {
+		fmt.Println("Error: harap masukkan nilai r1 yang valid")
+		return
+	}
+
+	fmt.Print("Rasio langkah genap (r2): ")
+	if err := stdin.readFloat(&r2); err != nil {
+		fmt.Println("Error: harap masukkan nilai r2 yang valid")
+		return
+	}
+
+	n, err := spec.readN(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, n: n}
+	fmt.Printf("\nIteratif: %.10f\n", calc.AlternatingRatioSum(r1, r2))
+	fmt.Printf("Bentuk tertutup (pasangan, rasio r1·r2 = %g): %.10f\n", r1*r2, calc.alternatingRatioSumClosed(r1, r2))
+}
+
+// DecayingRatioProgram compares the sum with a geometrically decaying ratio against the
+// ordinary sum with a constant ratio
+func DecayingRatioProgram() {
+	fmt.Println("\n=== Deret dengan Rasio yang Meluruh ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var d float64
+	fmt.Print("Faktor peluruhan rasio (d > 0): ")
+	if err := stdin.readFloat(&d); err != nil || d <= 0 {
+		fmt.Println("Error: harap masukkan nilai d > 0")
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	fmt.Printf("\nRasio meluruh (r·d^i):  %.10g\n", calc.DecayingRatioSum(d))
+	fmt.Printf("Rasio tetap (r):        %.10g\n", calc.GeometricSumFormula())
+	fmt.Printf("Rasio setelah suku ke-%d: %g\n", n, r*math.Pow(d, float64(n-1)))
+	fmt.Println("Catatan: tidak ada bentuk tertutup sederhana; hasil dihitung secara iteratif")
+}
+
+// RoundingProgram compares the iterative sum under the four rounding modes
+func RoundingProgram() {
+	fmt.Println("\n=== Mode Pembulatan ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	ref := calc.GeometricSumBig(referencePrec)
+	nearest := calc.GeometricSumRounded(RoundNearest)
+
+	fmt.Printf("\n%-12s %-24s %-17s %s\n", "Mode", "Hasil", "ULP dari terdekat", "Galat relatif")
+	for _, mode := range roundingModes {
+		result := calc.GeometricSumRounded(mode)
+		ulps := int64(math.Float64bits(result)) - int64(math.Float64bits(nearest))
+		fmt.Printf("%-12s %-24.17g %-17d %.3e\n", mode, result, ulps, relativeError(result, ref))
+	}
+}
+
+// WarmUpSweepProgram shows how the warm-up count affects the stability of the measurements,
+// reporting the coefficient of variation across cfg.Runs measurements at each count. The
+// recursive method is omitted when n exceeds cfg.MaxRecursionDepth.
+func WarmUpSweepProgram(cfg BenchmarkConfig) {
+	fmt.Println("\n=== Pengaruh Jumlah Pemanasan ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	type timedMethod struct {
+		label string
+		f     func()
+	}
+	methods := []timedMethod{{"Iteratif", func() { calc.GeometricSumIterative() }}}
+	if n <= cfg.MaxRecursionDepth {
+		methods = append(methods, timedMethod{"Rekursif", func() { calc.GeometricSumRecursive() }})
+	}
+
+	fmt.Printf("\n%-10s %-10s %-14s %s\n", "Pemanasan", "Metode", "Rata-rata (ns)", "Koef. variasi")
+	for _, warmUp := range []int{0, 100, 1000, 10000} {
+		for _, m := range methods {
+			times := make([]float64, cfg.Runs)
+			for i := range times {
+				times[i] = measureExecutionTimeWith(m.f, warmUp, cfg.Iterations)
+			}
+			mean, stddev, _, _ := timingStats(times)
+			fmt.Printf("%-10d %-10s %-14.3f %.2f%%\n", warmUp, m.label, mean, stddev/mean*100)
+		}
+	}
+	if n > cfg.MaxRecursionDepth {
+		fmt.Printf("\nRekursif: dilewati (n=%d melebihi batas kedalaman rekursi %d)\n", n, cfg.MaxRecursionDepth)
+	}
+	fmt.Printf("\nPemanasan yang dipakai saat ini: %d\n", cfg.WarmUpRuns)
+}
+
+// CompensatedProgram compares plain, Kahan and Neumaier summation in speed and accuracy
+func CompensatedProgram() {
+	fmt.Println("\n=== Penjumlahan Terkompensasi: Kahan vs Neumaier ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	ref := calc.GeometricSumBig(referencePrec)
+
+	fmt.Printf("\n%-10s %-24s %-14s %s\n", "Metode", "Hasil", "Waktu (ns)", "Galat relatif")
+	for _, m := range []struct {
+		label string
+		sum   func(g *GeometricCalculator) float64
+	}{
+		{"Iteratif", (*GeometricCalculator).GeometricSumIterative},
+		{"Kahan", (*GeometricCalculator).GeometricSumKahan},
+		{"Neumaier", (*GeometricCalculator).GeometricSumNeumaier},
+	} {
+		var result float64
+		timeNs := averageTime(func() {
+			result = m.sum(calc)
+		})
+		fmt.Printf("%-10s %-24.17g %-14.3f %.3e\n", m.label, result, timeNs, relativeError(result, ref))
+	}
+}
+
+// CycleProgram detects and reports periodic partial sums, e.g. for r = -1
+func CycleProgram() {
+	fmt.Println("\n=== Deteksi Periodisitas Jumlah Parsial ===")
+	spec := signedSeries
+	spec.NLabel = "Batas langkah pencarian (n): "
+	a, r, n, err := spec.read(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	start, length, found, settled := calc.DetectPartialSumCycle()
+	if settled {
+		fmt.Println("\nTidak ada siklus: suku menjadi nol atau tak hingga sehingga jumlah parsial berhenti berubah")
+		fmt.Println("(titik tetap floating point, bukan periodisitas)")
+		return
+	}
+	if !found {
+		fmt.Printf("\nTidak ada siklus dalam %d langkah pertama\n", n)
+		return
+	}
+
+	fmt.Printf("\nSiklus ditemukan: mulai pada S_%d, panjang %d\n", start, length)
+	state := sumState{term: a}
+	for i := 0; i < start; i++ {
+		state = calc.nextState(state)
+	}
+	values := make([]string, length)
+	for i := range values {
+		values[i] = strconv.FormatFloat(state.sum, 'g', -1, 64)
+		state = calc.nextState(state)
+	}
+	fmt.Printf("Nilai berulang: %s, ...\n", strings.Join(values, ", "))
+}
+
+// PositProgram compares a posit sum of a chosen format with float32, float64 and the
+// big.Float reference
+func PositProgram() {
+	fmt.Println("\n=== Penjumlahan dengan Aritmetika Posit ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var nbits, es int
+	fmt.Printf("Lebar posit (3-%d bit): ", maxPositBits)
+	if err := stdin.readInt(&nbits); err != nil || nbits < 3 || nbits > maxPositBits {
+		fmt.Printf("Error: harap masukkan lebar antara 3 dan %d\n", maxPositBits)
+		return
+	}
+	fmt.Printf("Bit eksponen (0-%d): ", maxPositES)
+	if err := stdin.readInt(&es); err != nil || !validPositFormat(nbits, es) {
+		fmt.Printf("Error: harap masukkan bit eksponen antara 0 dan %d\n", maxPositES)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	ref := calc.GeometricSumBig(referencePrec)
+
+	fmt.Printf("\n%-14s %-24s %s\n", "Format", "Hasil", "Galat relatif")
+	for _, row := range []struct {
+		label string
+		value float64
+	}{
+		{fmt.Sprintf("Posit(%d,%d)", nbits, es), calc.GeometricSumPosit(nbits, es)},
+		{"float32", calc.GeometricSumFloat32()},
+		{"float64", calc.GeometricSumIterative()},
+	} {
+		fmt.Printf("%-14s %-24.17g %.3e\n", row.label, row.value, relativeError(row.value, ref))
+	}
+}
+
+// MaxTermProgram reports the term of largest magnitude and where it occurs
+func MaxTermProgram() {
+	fmt.Println("\n=== Suku dengan Magnitudo Terbesar ===")
+	a, r, n, err := signedSeries.read(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	value, index := calc.MaxTerm()
+	fmt.Printf("\nSuku terbesar: %g (suku ke-%d dari %d)\n", value, index, n)
+}
+
+// chainedTermCount rounds the sum of a first-stage series to the term count of the second
+// stage, rejecting counts that are not positive or exceed maxChainedN
+func chainedTermCount(sum float64) (int, error) {
+	rounded := math.Round(sum)
+	if math.IsNaN(rounded) || rounded < 1 {
+		return 0, fmt.Errorf("jumlah tahap pertama %g dibulatkan menjadi n = %g, harus >= 1", sum, rounded)
+	}
+	if rounded > maxChainedN {
+		return 0, fmt.Errorf("jumlah tahap pertama %g melebihi batas n = %d", sum, maxChainedN)
+	}
+	return int(rounded), nil
+}
+
+// TwoStageProgram uses the rounded sum of one series as the term count of a second series
+func TwoStageProgram() {
+	fmt.Println("\n=== Deret Dua Tahap ===")
+	fmt.Println("Tahap 1:")
+	a1, r1, n1, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	first := &GeometricCalculator{a: a1, r: r1, n: n1}
+	sum1 := first.GeometricSumFormula()
+	n2, err := chainedTermCount(sum1)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Printf("\nJumlah tahap 1: %.6f -> n tahap 2 = %d\n", sum1, n2)
+
+	var spec seriesPrompt
+	fmt.Println("\nTahap 2:")
+	a2, err := spec.readA(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	r2, err := spec.readR(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	second := &GeometricCalculator{a: a2, r: r2, n: n2}
+	fmt.Printf("\nJumlah tahap 2 (a = %g, r = %g, n = %d): %.6f\n", a2, r2, n2, second.GeometricSumFormula())
+}
+
+// SumDifferenceProgram displays S(n2) - S(n1), the sum of the terms between two term counts
+func SumDifferenceProgram() {
+	fmt.Println("\n=== Selisih Jumlah antara Dua Banyak Suku ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var n1, n2 int
+	fmt.Printf("Banyak suku n1 (0-%d): ", n)
+	if err := stdin.readInt(&n1); err != nil {
+		fmt.Println("Error: harap masukkan bilangan bulat")
+		return
+	}
+	fmt.Printf("Banyak suku n2 (%d-%d): ", n1, n)
+	if err := stdin.readInt(&n2); err != nil {
+		fmt.Println("Error: harap masukkan bilangan bulat")
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	diff, err := calc.SumDifference(n1, n2)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Printf("\nS(%d) - S(%d) = jumlah suku %d..%d: %.17g\n", n2, n1, n1+1, n2, diff)
+}
+
+// NeighborsProgram prints the sum with its one-ULP neighbors and the gaps between them
+func NeighborsProgram() {
+	fmt.Println("\n=== Jumlah dan Tetangga 1 ULP ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	prev, value, next := calc.SumWithNeighbors()
+	fmt.Printf("\nSebelumnya: %.17g\n", prev)
+	fmt.Printf("Jumlah:     %.17g\n", value)
+	fmt.Printf("Berikutnya: %.17g\n", next)
+	fmt.Printf("\nJarak ke bawah: %.3e\n", value-prev)
+	fmt.Printf("Jarak ke atas:  %.3e\n", next-value)
+	if value != 0 {
+		fmt.Printf("Jarak relatif:  %.3e\n", relDiff(next, value))
+	}
+}
+
+// DistributionMeanProgram frames r as a failure probability and compares the mean of the
+// geometric distribution with its truncation to n trials
+func DistributionMeanProgram() {
+	fmt.Println("\n=== Rata-rata Distribusi Geometri ===")
+	spec := seriesPrompt{
+		SignedR: true,
+		RLabel:  "Peluang gagal per percobaan (r, 0 < r < 1): ",
+		NLabel:  "Jumlah percobaan yang dihitung (n): ",
+	}
+	r, err := spec.readR(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	n, err := spec.readN(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: 1 - r, r: r, n: n}
+	mean, err := calc.GeometricDistributionMean()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	truncated, _ := calc.GeometricDistributionMeanTruncated()
+	mass := calc.GeometricSumFormula()
+
+	fmt.Println()
+	fmt.Printf("%-42s %.10f\n", "Rata-rata percobaan hingga berhasil:", mean)
+	fmt.Printf("%-42s %.10f\n", fmt.Sprintf("Ekspektasi parsial hingga %d percobaan:", n), truncated)
+	fmt.Printf("%-42s %.10f\n", fmt.Sprintf("Peluang berhasil dalam %d percobaan:", n), mass)
+	fmt.Printf("%-42s %.10f\n", fmt.Sprintf("Rata-rata bersyarat (X <= %d):", n), truncated/mass)
+}
+
+// BudgetWindowProgram shows which B consecutive terms best approximate the full sum
+func BudgetWindowProgram() {
+	fmt.Println("\n=== Jendela Suku Terbaik untuk Anggaran B ===")
+	a, r, n, err := signedSeries.read(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var budget int
+	fmt.Printf("Anggaran suku (B, 1-%d): ", n)
+	if err := stdin.readInt(&budget); err != nil || budget < 1 || budget > n {
+		fmt.Printf("Error: harap masukkan B antara 1 dan %d\n", n)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	start, approx := calc.BestBudgetWindow(budget)
+	full := calc.GeometricSumFormula()
+	fmt.Printf("\nJendela terbaik: suku %d..%d\n", start, start+budget-1)
+	fmt.Printf("Jumlah jendela: %.10g\n", approx)
+	fmt.Printf("Jumlah penuh:   %.10g\n", full)
+	fmt.Printf("Galat: %.3e (relatif %.3e)\n", math.Abs(full-approx), relDiff(approx, full))
+}
+
+// SumsAndProductsProgram prints the partial sums next to the log partial products
+func SumsAndProductsProgram() {
+	fmt.Println("\n=== Jumlah Parsial dan Hasil Kali Parsial ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	sums, logProducts := calc.PartialSumsAndProducts()
+	fmt.Printf("\n%-8s %-24s %s\n", "k", "Jumlah parsial", "ln |hasil kali parsial|")
+	for i := range sums {
+		if i == maxPrintedRows {
+			fmt.Printf("... (%d baris berikutnya tidak ditampilkan)\n", n-maxPrintedRows)
+			break
+		}
+		fmt.Printf("%-8d %-24.10g %.10g\n", i+1, sums[i], logProducts[i])
+	}
+}
+
+// SumMethod describes a registered summation method
+type SumMethod struct {
+	Name      string                               // Nama untuk flag dan laporan
+	Label     string                               // Nama tampilan
+	Recursive bool                                 // Tunduk pada batas kedalaman rekursi
+	Sum       func(g *GeometricCalculator) float64 // Fungsi penghitung jumlah
+}
+
+// sumMethods is the registry of summation methods available to -method and the full report
+var sumMethods = []SumMethod{
+	{Name: "iterative", Label: "Iteratif", Sum: (*GeometricCalculator).GeometricSumIterative},
+	{Name: "recursive", Label: "Rekursif", Recursive: true, Sum: (*GeometricCalculator).GeometricSumRecursive},
+	{Name: "formula", Label: "Rumus", Sum: (*GeometricCalculator).GeometricSumFormula},
+	{Name: "kahan", Label: "Kahan", Sum: (*GeometricCalculator).GeometricSumKahan},
+	{Name: "neumaier", Label: "Neumaier", Sum: (*GeometricCalculator).GeometricSumNeumaier},
+}
+
+// methodResult computes the sum of calc using the named registered method
+func methodResult(calc *GeometricCalculator, method string) (float64, error) {
+	names := make([]string, len(sumMethods))
+	for i, m := range sumMethods {
+		if m.Name == method {
+			return m.Sum(calc), nil
+		}
+		names[i] = m.Name
+	}
+	return 0, fmt.Errorf("metode tidak dikenal: %s (gunakan %s)", method, strings.Join(names, ", "))
+}
+
+// QuietProgram prints only the sum computed by method, on a single line
+func QuietProgram(calc *GeometricCalculator, method string) error {
+	result, err := methodResult(calc, method)
+	if err != nil {
+		return err
+	}
+	fmt.Println(strconv.FormatFloat(result, 'g', -1, 64))
+	return nil
+}
+
+// parseExpected interprets spec as a number, or otherwise as a file containing the number
+func parseExpected(spec string) (float64, error) {
+	if v, err := strconv.ParseFloat(spec, 64); err == nil {
+		return v, nil
+	}
+	data, err := os.ReadFile(spec)
+	if err != nil {
+		return 0, fmt.Errorf("nilai acuan %q bukan angka maupun file yang dapat dibaca", spec)
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(string(data)), 64)
+	if err != nil {
+		return 0, fmt.Errorf("isi file %s bukan angka yang valid", spec)
+	}
+	return v, nil
+}
+
+// ExpectProgram checks the formula result of calc against a reference value produced by
+// another implementation and prints PASS or FAIL together with the Go timing
+func ExpectProgram(calc *GeometricCalculator, spec string, tol float64) (bool, error) {
+	expected, err := parseExpected(spec)
+	if err != nil {
+		return false, err
+	}
+
+	var result float64
+	timeNs := averageTime(func() {
+		result = calc.GeometricSumFormula()
+	})
+
+	diff := relDiff(result, expected)
+	passed := diff <= tol
+
+	status := "FAIL"
+	if passed {
+		status = "PASS"
+	}
+	fmt.Printf("%s: Go=%s acuan=%s selisih relatif=%.3e (toleransi %g, waktu Go: %.3f ns)\n",
+		status, strconv.FormatFloat(result, 'g', -1, 64), strconv.FormatFloat(expected, 'g', -1, 64), diff, tol, timeNs)
+	return passed, nil
+}
+
+// FullReportProgram benchmarks every registered method and writes the full report to a file
+func FullReportProgram(cfg BenchmarkConfig) {
+	fmt.Println("\n=== Laporan Lengkap ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Print("Nama file laporan (.md atau .json) [laporan.md]: ")
+	path, err := stdin.readLine()
+	if err != nil {
+		fmt.Println("Error: gagal membaca nama file")
+		return
+	}
+	if path == "" {
+		path = "laporan.md"
+	}
+
+	fmt.Println("\nMengukur semua metode...")
+	report := BuildFullReport(&GeometricCalculator{a: a, r: r, n: n}, cfg)
+	if err := report.Write(path); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	for i, m := range report.Methods {
+		if m.Skipped {
+			fmt.Printf("-. %s: dilewati\n", m.Label)
+			continue
+		}
+		fmt.Printf("%d. %s: %.3f ns (galat relatif %.3e)\n", i+1, m.Label, m.Mean, m.RelError)
+	}
+	fmt.Printf("\nLaporan ditulis ke %s\n", path)
+}
+
+// main is the entry point of the program
+func main() {
+	dbPath := flag.String("db", "", "file database SQLite untuk menyimpan setiap hasil perbandingan")
+	quiet := flag.Bool("quiet", false, "hanya cetak hasil akhir tanpa header maupun prompt")
+	method := flag.String("method", "formula", "metode untuk mode -quiet: iterative, recursive, formula, kahan, atau neumaier")
+	flagA := flag.String("a", "0", "suku pertama (mode non-interaktif); nominal seperti \"Rp 1.000,50\" dengan -currency")
+	flagR := flag.Float64("r", 0, "rasio (mode non-interaktif)")
+	flagN := flag.Int("n", 0, "jumlah suku (mode non-interaktif)")
+	rSweep := flag.String("r-sweep", "", "sapu rasio dalam rentang start:end:step dengan -a dan -n tetap")
+	format := flag.String("format", "table", "format keluaran: table, csv, atau tsv")
+	batchPath := flag.String("batch", "", "proses baris \"a r n\" dari file, named pipe, atau - untuk stdin")
+	listenAddr := flag.String("listen", "", "terima koneksi TCP di alamat ini dan proses setiap baris \"a r n\"")
+	replay := flag.String("replay", "", "jalankan ulang manifest tersimpan dan bandingkan hasilnya")
+	manifestPath := flag.String("manifest", "", "tulis manifest reprodusibilitas JSON ke file ini (mode non-interaktif)")
+	htmlPath := flag.String("html", "", "tulis laporan HTML mandiri dengan tabel hasil dan grafik SVG ke file ini (mode non-interaktif)")
+	expect := flag.String("expect", "", "hasil acuan dari implementasi lain (angka atau path file berisi angka); mencetak PASS/FAIL")
+	assertPath := flag.String("assert", "", "file config asersi (mis. \"positive\", \"result < 1e6\", \"agree 1e-9\"); keluar dengan status 1 jika ada yang gagal")
+	expectTol := flag.Float64("expect-tol", 1e-9, "toleransi relatif untuk -expect")
+	cold := flag.Bool("cold", false, "ukur setiap pengujian dalam subproses baru tanpa pemanasan (mode non-interaktif)")
+	singleShot := flag.Bool("single-shot", false, "internal: ukur satu panggilan tiap metode dan cetak JSON")
+	oneline := flag.Bool("oneline", false, "cetak ringkasan perbandingan dalam satu baris (mode non-interaktif)")
+	contention := flag.Int("contention", 0, "jalankan perbandingan tanpa beban dan dengan N goroutine latar belakang (mode non-interaktif)")
+	hash := flag.Bool("hash", false, "cetak hash FNV dari pola bit ketiga hasil untuk deteksi perubahan numerik (mode non-interaktif)")
+	sigfigs := flag.Int("sigfigs", 0, "cetak hasil dengan jumlah digit signifikan ini; otomatis memakai big.Float di atas presisi float64")
+	var disp displayOptions
+	flag.StringVar(&disp.Locale, "number-locale", "", "format angka hasil: id (1.234,56) atau en (1,234.56)")
+	flag.StringVar(&disp.Currency, "currency", "", "simbol mata uang (mis. Rp): baca a sebagai nominal dan tampilkan hasil sebagai uang")
+	flag.BoolVar(&disp.Reliability, "reliability", false, "tampilkan penilaian keandalan hasil perbandingan (eksak, aproksimasi baik, peringatan presisi, overflow)")
+	cfg := DefaultBenchmarkConfig()
+	flag.IntVar(&cfg.MaxRecursionDepth, "max-depth", cfg.MaxRecursionDepth, "batas n untuk tolok ukur rekursif")
+	flag.BoolVar(&cfg.SubtractOverhead, "subtract-overhead", false, "kurangkan overhead pengukuran fungsi kosong dari waktu")
+	flag.Parse()
+
+	switch *format {
+	case "table", "csv", "tsv":
+	default:
+		fmt.Fprintf(os.Stderr, "Error: format tidak dikenal: %s (gunakan table, csv, atau tsv)\n", *format)
+		os.Exit(1)
+	}
+	if cfg.MaxRecursionDepth <= 0 {
+		fmt.Fprintln(os.Stderr, "Error: -max-depth harus > 0")
+		os.Exit(1)
+	}
+	if disp.Locale != "" {
+		if _, _, err := localeSeparators(disp.Locale); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	stdin.currency = disp.Currency != ""
+	var flagAValue float64
+	var err error
+	if stdin.currency {
+		flagAValue, err = parseCurrency(*flagA)
+	} else {
+		flagAValue, err = strconv.ParseFloat(*flagA, 64)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: nilai -a tidak valid: %s\n", *flagA)
+		os.Exit(1)
+	}
+
+	// Parameters given as flags select the non-interactive mode
+	nonInteractive := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "a" || f.Name == "r" || f.Name == "n" {
+			nonInteractive = true
+		}
+	})
+
+	// At most one mode may be selected, and the outputs written from a comparison result
+	// only combine with the modes that produce one
+	var modes []string
+	for _, m := range []struct {
+		name string
+		set  bool
+	}{
+		{"-listen", *listenAddr != ""},
+		{"-batch", *batchPath != ""},
+		{"-replay", *replay != ""},
+		{"-r-sweep", *rSweep != ""},
+		{"-single-shot", *singleShot},
+		{"-quiet", *quiet},
+		{"-cold", *cold},
+		{"-hash", *hash},
+		{"-contention", *contention != 0},
+		{"-sigfigs", *sigfigs > 0},
+		{"-expect", *expect != ""},
+		{"-oneline", *oneline},
+		{"-format " + *format, *format != "table" && *rSweep == ""}, // -r-sweep has its own CSV and TSV output
+	} {
+		if m.set {
+			modes = append(modes, m.name)
+		}
+	}
+	if len(modes) > 1 {
+		fmt.Fprintf(os.Stderr, "Error: %s tidak dapat digabungkan\n", strings.Join(modes, " dan "))
+		os.Exit(2)
+	}
+	producesResult := len(modes) == 0 || *oneline || strings.HasPrefix(modes[0], "-format")
+	for _, out := range []struct {
+		name string
+		set  bool
+	}{
+		{"-db", *dbPath != ""},
+		{"-manifest", *manifestPath != ""},
+		{"-html", *htmlPath != ""},
+		{"-assert", *assertPath != ""},
+	} {
+		if out.set && !producesResult {
+			fmt.Fprintf(os.Stderr, "Error: %s memerlukan hasil perbandingan dan tidak dapat digabungkan dengan %s\n", out.name, modes[0])
+			os.Exit(2)
+		}
+	}
+
+	var db *sql.DB
+	if *dbPath != "" {
+		var err error
+		db, err = openResultDB(*dbPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		defer db.Close()
+	}
+
+	if *listenAddr != "" {
+		if err := ListenProgram(*listenAddr); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *batchPath != "" {
+		if err := BatchProgram(*batchPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *replay != "" {
+		if err := ReplayProgram(*replay); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *rSweep != "" {
+		if err := RatioSweepProgram(flagAValue, *flagN, *rSweep, *format); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if nonInteractive || *quiet {
+		a, r, n := flagAValue, *flagR, *flagN
+		var err error
+		if nonInteractive {
+			err = checkParams(a, r, n)
+		} else {
+			a, r, n, err = validateInput(stdin, true)
+		}
+		if err == nil {
+			calc := &GeometricCalculator{a: a, r: r, n: n}
+			switch {
+			case *singleShot:
+				err = SingleShotProgram(calc, cfg)
+			case *quiet:
+				err = QuietProgram(calc, *method)
+			case *cold:
+				err = ColdBenchmarkProgram(calc, cfg)
+			case *hash:
+				err = HashProgram(calc, cfg)
+			case *contention != 0:
+				err = ContentionProgram(calc, cfg, *contention)
+			case *sigfigs > 0:
+				err = SigFigsProgram(calc, *sigfigs)
+			case *expect != "":
+				var passed bool
+				if passed, err = ExpectProgram(calc, *expect, *expectTol); err == nil && !passed {
+					os.Exit(1)
+				}
+			default:
+				var assertions []Assertion
+				if *assertPath != "" {
+					if assertions, err = LoadAssertions(*assertPath); err != nil {
+						break
+					}
+				}
+
+				// Notices go to stderr when stdout carries a machine-readable result
+				var res Result
+				machineReadable := *oneline || *format != "table"
+				notices := os.Stdout
+				if machineReadable {
+					notices = os.Stderr
+				}
+				switch {
+				case *oneline:
+					res = runComparison(calc, cfg)
+					fmt.Println(OneLineSummary(res))
+				case *format == "csv":
+					res = runComparison(calc, cfg)
+					err = WriteResultsCSV(os.Stdout, res)
+				case *format == "tsv":
+					res = runComparison(calc, cfg)
+					err = WriteResultsTSV(os.Stdout, res)
+				default:
+					res = compareAndReport(calc, db, cfg, disp)
+				}
+				if db != nil && machineReadable && err == nil {
+					err = InsertResult(db, res)
+				}
+				if *manifestPath != "" && err == nil {
+					if err = NewManifest(res, cfg).WriteManifest(*manifestPath); err == nil {
+						fmt.Fprintf(notices, "Manifest ditulis ke %s\n", *manifestPath)
+					}
+				}
+				if *htmlPath != "" && err == nil {
+					if err = res.WriteHTMLReport(*htmlPath); err == nil {
+						fmt.Fprintf(notices, "Laporan HTML ditulis ke %s\n", *htmlPath)
+					}
+				}
+				if len(assertions) > 0 && !RunAssertions(res, assertions, notices) && err == nil {
+					os.Exit(1)
+				}
+			}
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	for {
+		fmt.Println("========================================================")
+		fmt.Println("   PERBANDINGAN ALGORITMA ITERATIF DAN REKURSIF")
+		fmt.Println("           DALAM MENGHITUNG DERET GEOMETRI")
+		fmt.Println("========================================================")
+		fmt.Println("\nPilih mode program:")
+		fmt.Println("1. Perbandingan Metode iteratif dan rekursif")
+		fmt.Println("2. Jejak rekursi")
+		fmt.Println("3. Sensitivitas jumlah terhadap rasio (dS/dr)")
+		fmt.Println("4. Jumlah suku efektif")
+		fmt.Println("5. Jumlah dengan n pecahan")
+		fmt.Println("6. Studi akurasi Monte Carlo")
+		fmt.Println("7. Perbandingan dengan integral kontinu")
+		fmt.Println("8. Bentuk tertutup simbolik")
+		fmt.Println("9. Kecepatan dan akurasi rumus terhadap n")
+		fmt.Println("10. Titik berat indeks suku")
+		fmt.Println("11. Biaya validasi input")
+		fmt.Println("12. Peluruhan suku di bawah ambang")
+		fmt.Println("13. Akumulasi hibrida float32/float64")
+		fmt.Println("14. Pengaruh epsilon di sekitar r = 1")
+		fmt.Println("15. Pemajemukan dalam periode")
+		fmt.Println("16. Laporan lengkap semua metode")
+		fmt.Println("17. Rasio maksimum untuk konvergensi")
+		fmt.Println("18. Jumlah parsial berulang")
+		fmt.Println("19. Aritmetika interval")
+		fmt.Println("20. Suku genap dan ganjil")
+		fmt.Println("21. Deret dengan dua rasio bergantian")
+		fmt.Println("22. Tabel pencarian vs rumus")
+		fmt.Println("23. Perbandingan mode pembulatan")
+		fmt.Println("24. Pengaruh jumlah pemanasan")
+		fmt.Println("25. Penjumlahan terkompensasi (Kahan vs Neumaier)")
+		fmt.Println("26. Deteksi periodisitas jumlah parsial")
+		fmt.Println("27. Penjumlahan dengan aritmetika posit")
+		fmt.Println("28. Suku dengan magnitudo terbesar")
+		fmt.Println("29. Deret dua tahap (jumlah menjadi n)")
+		fmt.Println("30. Kueri rentang dengan pohon segmen")
+		fmt.Println("31. Pertumbuhan memori metode rekursif")
+		fmt.Println("32. Selisih jumlah antara dua banyak suku")
+		fmt.Println("33. Animasi konvergensi deret")
+		fmt.Println("34. Jumlah dan tetangga 1 ULP")
+		fmt.Println("35. Rata-rata distribusi geometri")
+		fmt.Println("36. Simulasi mantissa tereduksi")
+		fmt.Println("37. Hasilkan kode Go untuk deret")
+		fmt.Println("38. Simulasi flush-to-zero denormal")
+		fmt.Println("39. Deret geometri modulo m")
+		fmt.Println("40. Biaya pemanggilan metode vs fungsi")
+		fmt.Println("41. Jendela suku terbaik untuk anggaran B")
+		fmt.Println("42. Jumlah parsial dan hasil kali parsial")
+		fmt.Println("43. Deret dengan rasio yang meluruh")
+		fmt.Println("44. Estimasi jumlah dari suku sampel")
+		fmt.Println("45. Pengaruh urutan penjumlahan")
+		fmt.Println("46. Ringkasan konvergensi")
+		fmt.Println("0. Keluar")
+		fmt.Print("\nMasukkan pilihan Anda (0-46): ")
+
+		var choice int
+		line, err := stdin.readLine()
+		if err != nil {
+			fmt.Println()
+			return
+		}
+		if choice, err = strconv.Atoi(line); err != nil {
+			choice = -1
+		}
+
+		switch choice {
+		case 1:
+			ComparisonProgram(db, cfg, disp)
+		case 2:
+			TraceProgram(cfg)
+		case 3:
+			SensitivityProgram()
+		case 4:
+			EffectiveCountProgram()
+		case 5:
+			FractionalProgram()
+		case 6:
+			AccuracyStudyProgram()
+		case 7:
+			IntegralProgram()
+		case 8:
+			ExpressionProgram()
+		case 9:
+			FormulaSweepProgram()
+		case 10:
+			CentroidProgram()
+		case 11:
+			ValidationBenchmarkProgram()
+		case 12:
+			TermDecayProgram()
+		case 13:
+			HybridProgram()
+		case 14:
+			EpsilonProgram()
+		case 15:
+			SubdividedProgram()
+		case 16:
+			FullReportProgram(cfg)
+		case 17:
+			MaxRatioProgram()
+		case 18:
+			PartialSumProgram()
+		case 19:
+			IntervalProgram()
+		case 20:
+			ParityProgram()
+		case 21:
+			AlternatingRatioProgram()
+		case 22:
+			TableProgram()
+		case 23:
+			RoundingProgram()
+		case 24:
+			WarmUpSweepProgram(cfg)
+		case 25:
+			CompensatedProgram()
+		case 26:
+			CycleProgram()
+		case 27:
+			PositProgram()
+		case 28:
+			MaxTermProgram()
+		case 29:
+			TwoStageProgram()
+		case 30:
+			SegmentTreeProgram()
+		case 31:
+			RecursiveMemoryProgram(cfg)
+		case 32:
+			SumDifferenceProgram()
+		case 33:
+			AnimationProgram()
+		case 34:
+			NeighborsProgram()
+		case 35:
+			DistributionMeanProgram()
+		case 36:
+			ReducedMantissaProgram()
+		case 37:
+			GoSnippetProgram()
+		case 38:
+			FlushToZeroProgram()
+		case 39:
+			ModularProgram()
+		case 40:
+			DispatchProgram()
+		case 41:
+			BudgetWindowProgram()
+		case 42:
+			SumsAndProductsProgram()
+		case 43:
+			DecayingRatioProgram()
+		case 44:
+			SampledSumProgram()
+		case 45:
+			OrderProgram()
+		case 46:
+			ConvergenceInfoProgram()
+		case 0:
+			fmt.Println("Terima kasih telah menggunakan program ini. Sampai jumpa!")
+			return
+		default:
+			fmt.Println("Pilihan tidak valid! Harap pilih 0-46.")
+		}
+
+		fmt.Println("\nTekan Enter untuk kembali ke menu utama...")
+		if _, err := stdin.readLine(); err != nil { // Tunggu pengguna untuk melanjutkan
+			return
+		}
+	}
+}
@@ -0,0 +1,393 @@
+package main
+
+import (
+	"io"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestGeometricSumRecursiveTracedMatchesRecursive(t *testing.T) {
+	tests := []GeometricCalculator{
+		{a: 2, r: 0.5, n: 1},
+		{a: 2, r: 0.5, n: 10},
+		{a: 1, r: 1, n: 7},
+		{a: 3, r: 1.5, n: maxTraceDepth + 5},
+		{a: 0.1, r: 0.9, n: 1000},
+	}
+	for _, calc := range tests {
+		got := calc.GeometricSumRecursiveTraced(io.Discard)
+		want := calc.GeometricSumRecursive()
+		if got != want {
+			t.Errorf("a=%g r=%g n=%d: traced = %v, recursive = %v", calc.a, calc.r, calc.n, got, want)
+		}
+	}
+}
+
+func TestSumDerivativeWrtRMatchesFiniteDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		calc GeometricCalculator
+		h    float64
+	}{
+		{"r < 1", GeometricCalculator{a: 2, r: 0.5, n: 10}, 1e-6},
+		{"r > 1", GeometricCalculator{a: 1.5, r: 1.2, n: 15}, 1e-6},
+		// The formula cancels badly at r = 1 ± h, so a larger step keeps rounding below the tolerance
+		{"r = 1", GeometricCalculator{a: 3, r: 1, n: 20}, 1e-4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analytic := tt.calc.SumDerivativeWrtR()
+			numeric := tt.calc.sumDerivativeFiniteDiff(tt.h)
+			if d := relDiff(analytic, numeric); d > 1e-6 {
+				t.Errorf("SumDerivativeWrtR = %v, finite difference = %v (relative difference %.3e)", analytic, numeric, d)
+			}
+		})
+	}
+}
+
+func TestSumWithEffectiveCount(t *testing.T) {
+	tests := []struct {
+		name   string
+		calc   GeometricCalculator
+		relTol float64
+		want   int
+	}{
+		// 0.5^32 ≈ 2.3e-10 still exceeds 1e-10 of the sum ≈ 2, 0.5^33 does not
+		{"convergent", GeometricCalculator{a: 1, r: 0.5, n: 100}, 1e-10, 33},
+		{"convergent shorter than cutoff", GeometricCalculator{a: 1, r: 0.5, n: 20}, 1e-10, 20},
+		{"divergent", GeometricCalculator{a: 1, r: 2, n: 100}, 1e-10, 100},
+		{"constant", GeometricCalculator{a: 3, r: 1, n: 50}, 1e-3, 50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum, effectiveN := tt.calc.SumWithEffectiveCount(tt.relTol)
+			if want := tt.calc.GeometricSumIterative(); sum != want {
+				t.Errorf("sum = %v; want iterative sum %v", sum, want)
+			}
+			if effectiveN != tt.want {
+				t.Errorf("effectiveN = %d; want %d", effectiveN, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometricIntegralMatchesQuadrature(t *testing.T) {
+	for _, calc := range []GeometricCalculator{{a: 2, r: 0.5, n: 10}, {a: 1, r: 1.2, n: 25}} {
+		got, err := calc.GeometricIntegral()
+		if err != nil {
+			t.Fatalf("a=%g r=%g n=%d: %v", calc.a, calc.r, calc.n, err)
+		}
+
+		// Composite Simpson's rule over [0, n]
+		const steps = 10000
+		h := float64(calc.n) / steps
+		f := func(x float64) float64 { return calc.a * math.Pow(calc.r, x) }
+		want := f(0) + f(float64(calc.n))
+		for i := 1; i < steps; i++ {
+			want += float64(2+2*(i%2)) * f(float64(i)*h)
+		}
+		want *= h / 3
+
+		if d := relDiff(got, want); d > 1e-10 {
+			t.Errorf("a=%g r=%g n=%d: integral = %v, quadrature = %v", calc.a, calc.r, calc.n, got, want)
+		}
+	}
+}
+
+func TestGeometricIntegralLargeN(t *testing.T) {
+	// The sum and the integral share the factor r^n - 1, so their ratio is -ln(r)/(1 - r)
+	// for every n; at large n this checks that neither side loses that factor to
+	// underflow (r < 1) or to rounding of the huge r^n (r > 1)
+	for _, calc := range []GeometricCalculator{{a: 3, r: 0.9, n: 5000}, {a: 3, r: 1.05, n: 5000}} {
+		integral, err := calc.GeometricIntegral()
+		if err != nil {
+			t.Fatalf("r=%g: %v", calc.r, err)
+		}
+		got := calc.GeometricSumFormula() / integral
+		want := -math.Log(calc.r) / (1 - calc.r)
+		if d := relDiff(got, want); d > 1e-9 {
+			t.Errorf("r=%g n=%d: sum/integral = %v; want %v", calc.r, calc.n, got, want)
+		}
+	}
+}
+
+func TestGeometricIntegralErrors(t *testing.T) {
+	for _, r := range []float64{0, -0.5, 1} {
+		calc := GeometricCalculator{a: 2, r: r, n: 10}
+		if _, err := calc.GeometricIntegral(); err == nil {
+			t.Errorf("GeometricIntegral accepted r = %g", r)
+		}
+	}
+}
+
+func TestValidateInputReadsOneValuePerLine(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"trailing newline", "2\n0.5\n10\n"},
+		{"final line without newline", "2\n0.5\n10"},
+		{"CRLF line endings", "2\r\n0.5\r\n10\r\n"},
+		{"surrounding whitespace", "  2 \n\t0.5\n 10 \n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, r, n, err := validateInput(newLineReader(strings.NewReader(tt.input)), true)
+			if err != nil {
+				t.Fatalf("validateInput(%q) error: %v", tt.input, err)
+			}
+			if a != 2 || r != 0.5 || n != 10 {
+				t.Errorf("validateInput(%q) = %v, %v, %v; want 2, 0.5, 10", tt.input, a, r, n)
+			}
+		})
+	}
+}
+
+func TestValidateInputBadLineDoesNotDesync(t *testing.T) {
+	in := newLineReader(strings.NewReader("abc\n0.5\n10\n"))
+	if _, _, _, err := validateInput(in, true); err == nil {
+		t.Fatal("validateInput accepted a = \"abc\"")
+	}
+
+	// The rejected line is consumed whole, so the next prompt sees the next line
+	var r float64
+	if err := in.readFloat(&r); err != nil || r != 0.5 {
+		t.Fatalf("readFloat after bad line = %v, %v; want 0.5", r, err)
+	}
+	var n int
+	if err := in.readInt(&n); err != nil || n != 10 {
+		t.Fatalf("readInt after bad line = %v, %v; want 10", n, err)
+	}
+}
+
+func TestValidateInputRetryAfterBadLine(t *testing.T) {
+	in := newLineReader(strings.NewReader("2\n0.5 x\n10\n2\n0.5\n10\n"))
+	if _, _, _, err := validateInput(in, true); err == nil {
+		t.Fatal("validateInput accepted r = \"0.5 x\"")
+	}
+
+	// A second attempt starts at the line after the rejected one
+	var n int
+	if err := in.readInt(&n); err != nil || n != 10 {
+		t.Fatalf("readInt after rejected r = %v, %v; want 10", n, err)
+	}
+	a, r, n, err := validateInput(in, true)
+	if err != nil || a != 2 || r != 0.5 || n != 10 {
+		t.Errorf("second validateInput = %v, %v, %v, %v; want 2, 0.5, 10, nil", a, r, n, err)
+	}
+}
+
+func TestTermsUntilBelowMatchesScan(t *testing.T) {
+	calcs := []GeometricCalculator{
+		{a: 1, r: 0.5, n: 1},
+		{a: 1, r: 0.9, n: 1},
+		{a: 1000, r: 0.1, n: 1},
+		{a: 0.25, r: 0.999, n: 1},
+	}
+	for _, calc := range calcs {
+		term := func(k int) float64 { return calc.a * math.Pow(calc.r, float64(k-1)) }
+
+		// Thresholds equal to a term, or one ULP either side of it, are where the logarithm
+		// estimate lands one position off and the boundary corrections have to step it back
+		thresholds := []float64{2 * calc.a, 1e-3, 1e-9}
+		for m := 1; m <= 60; m++ {
+			thresholds = append(thresholds, term(m), math.Nextafter(term(m), 0), math.Nextafter(term(m), math.Inf(1)))
+		}
+
+		for _, threshold := range thresholds {
+			got, err := calc.TermsUntilBelow(threshold)
+			if err != nil {
+				t.Fatalf("a=%g r=%g threshold=%g: %v", calc.a, calc.r, threshold, err)
+			}
+			want := 1
+			for term(want) >= threshold {
+				want++
+			}
+			if got != want {
+				t.Errorf("a=%g r=%g threshold=%v: TermsUntilBelow = %d; want %d", calc.a, calc.r, threshold, got, want)
+			}
+		}
+	}
+}
+
+func TestTermsUntilBelowErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		r         float64
+		threshold float64
+	}{
+		{"zero threshold", 0.5, 0},
+		{"negative threshold", 0.5, -1},
+		{"r = 1", 1, 0.1},
+		{"r > 1", 2, 0.1},
+		{"r = 0", 0, 0.1},
+	}
+	for _, tt := range tests {
+		calc := GeometricCalculator{a: 1, r: tt.r, n: 1}
+		if _, err := calc.TermsUntilBelow(tt.threshold); err == nil {
+			t.Errorf("%s: TermsUntilBelow accepted r=%g threshold=%g", tt.name, tt.r, tt.threshold)
+		}
+	}
+}
+
+func TestPercentOfLimitApproachesHundred(t *testing.T) {
+	prev := 0.0
+	for _, n := range []int{1, 5, 10, 50, 100, 500} {
+		calc := GeometricCalculator{a: 2, r: 0.9, n: n}
+		got, err := calc.PercentOfLimit()
+		if err != nil {
+			t.Fatalf("n=%d: %v", n, err)
+		}
+		// The missing share of the limit is exactly r^n
+		if want := 100 * (1 - math.Pow(calc.r, float64(n))); math.Abs(got-want) > 1e-9 {
+			t.Errorf("n=%d: PercentOfLimit = %v; want %v", n, got, want)
+		}
+		if got <= prev || got > 100 {
+			t.Errorf("n=%d: PercentOfLimit = %v, not increasing towards 100 from %v", n, got, prev)
+		}
+		prev = got
+	}
+	if 100-prev > 1e-9 {
+		t.Errorf("PercentOfLimit at n=500 = %v; want 100", prev)
+	}
+
+	divergent := GeometricCalculator{a: 2, r: 1.5, n: 10}
+	if _, err := divergent.PercentOfLimit(); err == nil {
+		t.Error("PercentOfLimit accepted r = 1.5")
+	}
+}
+
+func TestGeometricSumIntervalContainsFormula(t *testing.T) {
+	tests := []GeometricCalculator{
+		{a: 2, r: 0.5, n: 10},
+		{a: 1, r: 1, n: 100},
+		{a: 3, r: 1.5, n: 30},
+		{a: 0.1, r: 0.9, n: 500},
+		{a: 5, r: 1.1, n: 200},
+	}
+	// Near r = 1 the closed form loses digits to cancellation in 1 - r^n and can fall
+	// outside the enclosure, so only well-conditioned ratios are listed here
+	for _, calc := range tests {
+		lo, hi := calc.GeometricSumInterval()
+		if formula := calc.GeometricSumFormula(); formula < lo || formula > hi {
+			t.Errorf("a=%g r=%g n=%d: formula %v outside [%v, %v]", calc.a, calc.r, calc.n, formula, lo, hi)
+		}
+		exact := calc.GeometricSumBig(referencePrec)
+		if exact.Cmp(big.NewFloat(lo)) < 0 || exact.Cmp(big.NewFloat(hi)) > 0 {
+			t.Errorf("a=%g r=%g n=%d: exact sum %v outside [%v, %v]", calc.a, calc.r, calc.n, exact, lo, hi)
+		}
+	}
+}
+
+func TestSumEvenOddTerms(t *testing.T) {
+	tests := []GeometricCalculator{
+		{a: 2, r: 0.5, n: 0},
+		{a: 2, r: 0.5, n: 1},
+		{a: 2, r: 0.5, n: 10},
+		{a: 3, r: 1.3, n: 7},
+		{a: 1, r: 1, n: 9},
+		{a: 1, r: 1, n: 12},
+		{a: 5, r: -0.7, n: 15},
+		{a: 5, r: -0.7, n: 16},
+	}
+	for _, calc := range tests {
+		even, odd := calc.SumEvenTerms(), calc.SumOddTerms()
+		if d := relDiff(even+odd, calc.GeometricSumIterative()); d > 1e-12 {
+			t.Errorf("a=%g r=%g n=%d: even + odd = %v; want %v", calc.a, calc.r, calc.n, even+odd, calc.GeometricSumIterative())
+		}
+		if want := calc.sumByParityIterative(true); relDiff(even, want) > 1e-12 {
+			t.Errorf("a=%g r=%g n=%d: SumEvenTerms = %v; want %v", calc.a, calc.r, calc.n, even, want)
+		}
+		if want := calc.sumByParityIterative(false); relDiff(odd, want) > 1e-12 {
+			t.Errorf("a=%g r=%g n=%d: SumOddTerms = %v; want %v", calc.a, calc.r, calc.n, odd, want)
+		}
+	}
+}
+
+func TestAlternatingRatioSumClosedMatchesIterative(t *testing.T) {
+	ratios := []struct{ r1, r2 float64 }{
+		{0.5, 3},
+		{2, 0.5}, // r1·r2 = 1, so the paired series is constant
+		{-0.4, 1.5},
+		{1.1, 1.2},
+	}
+	for _, rr := range ratios {
+		for _, n := range []int{0, 1, 2, 3, 7, 8} {
+			calc := GeometricCalculator{a: 3, r: 1, n: n}
+			got := calc.alternatingRatioSumClosed(rr.r1, rr.r2)
+			want := calc.AlternatingRatioSum(rr.r1, rr.r2)
+			if relDiff(got, want) > 1e-12 {
+				t.Errorf("r1=%g r2=%g n=%d: closed form = %v, iterative = %v", rr.r1, rr.r2, n, got, want)
+			}
+		}
+	}
+}
+
+func TestSumDifferenceMatchesIterative(t *testing.T) {
+	tests := []struct {
+		name   string
+		calc   GeometricCalculator
+		n1, n2 int
+	}{
+		{"r < 1", GeometricCalculator{a: 2, r: 0.5, n: 40}, 5, 30},
+		{"r > 1", GeometricCalculator{a: 1, r: 1.3, n: 60}, 10, 60},
+		{"r = 1", GeometricCalculator{a: 4, r: 1, n: 100}, 20, 75},
+		{"r close to 1 (Expm1)", GeometricCalculator{a: 3, r: 1 + 1e-9, n: 1000}, 100, 900},
+		{"r just below 1 (Expm1)", GeometricCalculator{a: 3, r: 1 - 1e-7, n: 1000}, 0, 1000},
+		{"r < 0", GeometricCalculator{a: 2, r: -0.8, n: 50}, 3, 48},
+		{"r < 0, odd count", GeometricCalculator{a: 2, r: -1.5, n: 21}, 4, 21},
+		{"empty range", GeometricCalculator{a: 2, r: 0.5, n: 10}, 7, 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.calc.SumDifference(tt.n1, tt.n2)
+			if err != nil {
+				t.Fatalf("SumDifference(%d, %d): %v", tt.n1, tt.n2, err)
+			}
+			want := 0.0
+			term := tt.calc.a * math.Pow(tt.calc.r, float64(tt.n1))
+			for i := tt.n1; i < tt.n2; i++ {
+				want += term
+				term *= tt.calc.r
+			}
+			if d := relDiff(got, want); d > 1e-12 {
+				t.Errorf("SumDifference(%d, %d) = %.17g; iterative sum = %.17g (relative difference %.3e)", tt.n1, tt.n2, got, want, d)
+			}
+		})
+	}
+}
+
+func TestSumDifferenceRejectsBadRange(t *testing.T) {
+	calc := GeometricCalculator{a: 2, r: 0.5, n: 10}
+	for _, rng := range [][2]int{{-1, 5}, {6, 5}, {0, 11}} {
+		if _, err := calc.SumDifference(rng[0], rng[1]); err == nil {
+			t.Errorf("SumDifference(%d, %d) accepted", rng[0], rng[1])
+		}
+	}
+}
+
+func TestDetectPartialSumCycle(t *testing.T) {
+	tests := []struct {
+		name                   string
+		calc                   GeometricCalculator
+		wantStart, wantLength  int
+		wantFound, wantSettled bool
+	}{
+		{"alternating", GeometricCalculator{a: 1, r: -1, n: 10}, 0, 2, true, false},
+		{"cycle longer than n", GeometricCalculator{a: 1, r: -1, n: 1}, 0, 0, false, false},
+		{"convergent before underflow", GeometricCalculator{a: 1, r: 0.5, n: 10}, 0, 0, false, false},
+		{"convergent term underflows", GeometricCalculator{a: 1, r: 0.5, n: 5000}, 0, 0, false, true},
+		{"divergent term overflows", GeometricCalculator{a: 1, r: 2, n: 5000}, 0, 0, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, length, found, settled := tt.calc.DetectPartialSumCycle()
+			if start != tt.wantStart || length != tt.wantLength || found != tt.wantFound || settled != tt.wantSettled {
+				t.Errorf("DetectPartialSumCycle() = %d, %d, %v, %v; want %d, %d, %v, %v",
+					start, length, found, settled, tt.wantStart, tt.wantLength, tt.wantFound, tt.wantSettled)
+			}
+		})
+	}
+}
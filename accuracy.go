@@ -0,0 +1,127 @@
+package main
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+)
+
+const (
+	referencePrec      = 256   // Presisi mantissa big.Float untuk nilai acuan
+	maxRandomSeriesLen = 10000 // Jumlah suku maksimum deret acak
+)
+
+// accuracyStats holds the aggregate relative error of one method across many trials
+type accuracyStats struct {
+	name string
+	mean float64
+	max  float64
+}
+
+// relDiff returns the relative difference |a - b| / |b| of a from the reference b.
+// When b is zero the absolute difference is returned instead, and equal values
+// (including two zeros or two identical infinities) always give 0. Any other value
+// against an infinite reference is infinitely far off, and NaN on either side gives NaN.
+func relDiff(a, b float64) float64 {
+	if a == b {
+		return 0
+	}
+	return scaleDiff(math.Abs(a-b), b)
+}
+
+// scaleDiff turns the absolute difference diff from the reference b into relDiff's
+// relative one, returning diff unscaled when b is zero or infinite
+func scaleDiff(diff, b float64) float64 {
+	if b == 0 || math.IsInf(b, 0) {
+		return diff
+	}
+	return diff / math.Abs(b)
+}
+
+// relativeError is relDiff against a big.Float reference, computing the difference at
+// referencePrec so errors below one float64 ULP of the reference remain visible
+func relativeError(value float64, ref *big.Float) float64 {
+	refValue, _ := ref.Float64()
+	if math.IsNaN(value) || math.IsInf(value, 0) || ref.IsInf() {
+		// big.Float cannot hold NaN and panics on Inf - Inf
+		return relDiff(value, refValue)
+	}
+	diff := new(big.Float).SetPrec(referencePrec).SetFloat64(value)
+	diff.Sub(diff, ref)
+	d, _ := diff.Abs(diff).Float64()
+	return scaleDiff(d, refValue)
+}
+
+// randomConvergentSeries returns a random valid series with 0 < r < 1
+func randomConvergentSeries(rng *rand.Rand) *GeometricCalculator {
+	return &GeometricCalculator{
+		a: 0.1 + rng.Float64()*100,
+		r: math.Nextafter(0, 1) + rng.Float64()*(1-math.Nextafter(0, 1)),
+		n: 1 + rng.Intn(maxRandomSeriesLen),
+	}
+}
+
+// runAccuracyStudy computes trials random convergent series with the plain iterative, Kahan,
+// Neumaier, formula and 32-bit posit methods and aggregates their relative error against the
+// big.Float reference
+func runAccuracyStudy(trials int, seed int64) []accuracyStats {
+	rng := rand.New(rand.NewSource(seed))
+	methods := []struct {
+		name string
+		sum  func(g *GeometricCalculator) float64
+	}{
+		{"Iteratif", (*GeometricCalculator).GeometricSumIterative},
+		{"Kahan", (*GeometricCalculator).GeometricSumKahan},
+		{"Neumaier", (*GeometricCalculator).GeometricSumNeumaier},
+		{"Rumus", (*GeometricCalculator).GeometricSumFormula},
+		{"Posit(32,2)", func(g *GeometricCalculator) float64 { return g.GeometricSumPosit(32, 2) }},
+	}
+
+	stats := make([]accuracyStats, len(methods))
+	for i, m := range methods {
+		stats[i].name = m.name
+	}
+
+	for t := 0; t < trials; t++ {
+		calc := randomConvergentSeries(rng)
+		ref := calc.GeometricSumBig(referencePrec)
+		for i, m := range methods {
+			err := relativeError(m.sum(calc), ref)
+			stats[i].mean += err
+			stats[i].max = math.Max(stats[i].max, err)
+		}
+	}
+
+	for i := range stats {
+		stats[i].mean /= float64(trials)
+	}
+	return stats
+}
+
+const maxFormulaSweepN = 1000000 // Batas n pada sapuan rumus (acuan big.Float bersifat O(n))
+
+// formulaSweepRow holds the formula timing and accuracy at one term count
+type formulaSweepRow struct {
+	n        int
+	timeNs   float64
+	relError float64
+}
+
+// runFormulaSweep times the closed-form formula at n = 10, 100, ..., maxFormulaSweepN and
+// measures its relative error against the big.Float reference at each step
+func runFormulaSweep(a, r float64) []formulaSweepRow {
+	var rows []formulaSweepRow
+	for n := 10; n <= maxFormulaSweepN; n *= 10 {
+		calc := &GeometricCalculator{a: a, r: r, n: n}
+		var result float64
+		timeNs := averageTime(func() {
+			result = calc.GeometricSumFormula()
+		})
+		rows = append(rows, formulaSweepRow{
+			n:        n,
+			timeNs:   timeNs,
+			relError: relativeError(result, calc.GeometricSumBig(referencePrec)),
+		})
+	}
+	return rows
+}
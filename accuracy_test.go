@@ -0,0 +1,47 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRelDiff(t *testing.T) {
+	inf := math.Inf(1)
+	tests := []struct {
+		name string
+		a, b float64
+		want float64
+	}{
+		{"equal values", 2.5, 2.5, 0},
+		{"both zero", 0, 0, 0},
+		{"zero and negative zero", 0, math.Copysign(0, -1), 0},
+		{"zero reference gives absolute difference", 0.25, 0, 0.25},
+		{"negative value against zero reference", -3, 0, 3},
+		{"relative to reference", 1.5, 1, 0.5},
+		{"relative to negative reference", -1.5, -1, 0.5},
+		{"opposite signs", -1, 1, 2},
+		{"opposite signs against negative reference", 2, -4, 1.5},
+		{"same infinity", inf, inf, 0},
+		{"same negative infinity", -inf, -inf, 0},
+		{"opposite infinities", -inf, inf, inf},
+		{"infinite value", inf, 1, inf},
+		{"infinite reference", 1, -inf, inf},
+		{"infinite value against zero reference", -inf, 0, inf},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := relDiff(tt.a, tt.b); got != tt.want {
+				t.Errorf("relDiff(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelDiffNaN(t *testing.T) {
+	nan := math.NaN()
+	for _, pair := range [][2]float64{{nan, 1}, {1, nan}, {nan, 0}, {nan, nan}, {nan, math.Inf(1)}} {
+		if got := relDiff(pair[0], pair[1]); !math.IsNaN(got) {
+			t.Errorf("relDiff(%v, %v) = %v; want NaN", pair[0], pair[1], got)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	maxAnimationTerms    = 40   // Jumlah suku terbesar yang dianimasikan
+	animationBarWidth    = 50   // Lebar batang penuh dalam karakter
+	defaultAnimationStep = 300  // Jeda bawaan antarsuku (ms)
+	maxAnimationStep     = 5000 // Jeda terbesar yang diizinkan (ms)
+)
+
+// bar renders a bar of length fraction·animationBarWidth
+func bar(fraction float64) string {
+	width := int(math.Round(math.Max(0, math.Min(1, fraction)) * animationBarWidth))
+	return strings.Repeat("█", width) + strings.Repeat("·", animationBarWidth-width)
+}
+
+// AnimationProgram animates a convergent series: every term is drawn as a shrinking bar while
+// the running total grows toward the infinite limit. Pressing Enter skips to the end.
+func AnimationProgram() {
+	fmt.Println("\n=== Animasi Konvergensi Deret ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if r >= 1 {
+		fmt.Println("Error: animasi hanya untuk deret konvergen (0 < r < 1)")
+		return
+	}
+
+	step := defaultAnimationStep
+	fmt.Printf("Jeda antarsuku dalam ms (1-%d, kosong untuk %d): ", maxAnimationStep, defaultAnimationStep)
+	line, err := stdin.readLine()
+	if err != nil {
+		return
+	}
+	if line != "" {
+		if step, err = strconv.Atoi(line); err != nil || step < 1 || step > maxAnimationStep {
+			fmt.Printf("Error: harap masukkan jeda antara 1 dan %d\n", maxAnimationStep)
+			return
+		}
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	limit, _ := calc.GeometricSumInfinite()
+	terms := n
+	if terms > maxAnimationTerms {
+		terms = maxAnimationTerms
+	}
+
+	// The shared reader is read in the background so Enter can interrupt the animation
+	skip := make(chan struct{})
+	go func() {
+		stdin.readLine()
+		close(skip)
+	}()
+
+	fmt.Printf("\nTekan Enter untuk melewati animasi (maksimal %d suku)\n\n", terms)
+	sum, term := 0.0, a
+	skipped := false
+	for i := 1; i <= terms; i++ {
+		sum += term
+		fmt.Printf("\r\033[2Ksuku %-3d %s %.6g\n", i, bar(term/a), term)
+		fmt.Printf("jumlah   %s %.6g / %.6g", bar(sum/limit), sum, limit)
+		term *= r
+
+		if !skipped {
+			select {
+			case <-skip:
+				skipped = true
+			case <-time.After(time.Duration(step) * time.Millisecond):
+			}
+		}
+	}
+	fmt.Printf("\n\nJumlah %d suku mencapai %.4f%% dari limit %.6g\n", terms, sum/limit*100, limit)
+
+	if !skipped {
+		fmt.Println("Animasi selesai. Tekan Enter untuk melanjutkan...")
+		<-skip
+	}
+}
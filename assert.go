@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Assertion is one invariant from an assertion config that a comparison result must satisfy
+type Assertion struct {
+	Text  string            // Baris asli dari config
+	check func(Result) bool // Pemeriksa terhadap hasil perbandingan
+}
+
+// parseAssertion parses one config line. Supported forms:
+//
+//	positive              hasil rumus > 0
+//	finite                hasil rumus tidak Inf/NaN
+//	result <op> X         op salah satu <, <=, >, >=
+//	agree TOL             semua metode yang diukur sepakat dalam toleransi relatif TOL
+func parseAssertion(line string) (Assertion, error) {
+	fields := strings.Fields(line)
+	a := Assertion{Text: line}
+	switch {
+	case len(fields) == 1 && fields[0] == "positive":
+		a.check = func(res Result) bool { return res.Formula > 0 }
+	case len(fields) == 1 && fields[0] == "finite":
+		a.check = func(res Result) bool { return !math.IsInf(res.Formula, 0) && !math.IsNaN(res.Formula) }
+	case len(fields) == 2 && fields[0] == "agree":
+		tol, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil || tol < 0 {
+			return a, fmt.Errorf("toleransi tidak valid %q", fields[1])
+		}
+		a.check = func(res Result) bool {
+			agree := relDiff(res.Iterative, res.Formula) <= tol
+			if !res.RecursiveSkipped {
+				agree = agree && relDiff(res.Recursive, res.Formula) <= tol
+			}
+			return agree
+		}
+	case len(fields) == 3 && fields[0] == "result":
+		bound, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			return a, fmt.Errorf("batas tidak valid %q", fields[2])
+		}
+		switch fields[1] {
+		case "<":
+			a.check = func(res Result) bool { return res.Formula < bound }
+		case "<=":
+			a.check = func(res Result) bool { return res.Formula <= bound }
+		case ">":
+			a.check = func(res Result) bool { return res.Formula > bound }
+		case ">=":
+			a.check = func(res Result) bool { return res.Formula >= bound }
+		default:
+			return a, fmt.Errorf("operator tidak dikenal %q", fields[1])
+		}
+	default:
+		return a, fmt.Errorf("asersi tidak dikenal %q", line)
+	}
+	return a, nil
+}
+
+// ParseAssertions reads one assertion per line from in. Blank lines and lines starting with
+// # are skipped.
+func ParseAssertions(in io.Reader) ([]Assertion, error) {
+	var assertions []Assertion
+	scanner := bufio.NewScanner(in)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		a, err := parseAssertion(line)
+		if err != nil {
+			return nil, fmt.Errorf("baris %d: %v", lineNo, err)
+		}
+		assertions = append(assertions, a)
+	}
+	return assertions, scanner.Err()
+}
+
+// LoadAssertions reads the assertion config at path
+func LoadAssertions(path string) ([]Assertion, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membuka config asersi %s: %v", path, err)
+	}
+	defer f.Close()
+	assertions, err := ParseAssertions(f)
+	if err != nil {
+		return nil, fmt.Errorf("config asersi %s tidak valid: %v", path, err)
+	}
+	return assertions, nil
+}
+
+// RunAssertions checks every assertion against res, printing PASS or FAIL for each, and
+// reports whether all of them passed
+func RunAssertions(res Result, assertions []Assertion, out io.Writer) bool {
+	allPassed := true
+	fmt.Fprintln(out, "\n=== Asersi ===")
+	for _, a := range assertions {
+		status := "PASS"
+		if !a.check(res) {
+			status, allPassed = "FAIL", false
+		}
+		fmt.Fprintf(out, "%s: %s\n", status, a.Text)
+	}
+	return allPassed
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// BatchSummary counts the lines handled by RunBatch
+type BatchSummary struct {
+	Processed int                                 // Baris yang berhasil dihitung
+	Failed    int                                 // Baris yang gagal diurai atau divalidasi
+	Classes   [numConvergenceClasses]ClassSummary // Rekap per kelas konvergensi
+}
+
+// ClassSummary aggregates the processed series of one convergence class
+type ClassSummary struct {
+	Count    int     // Jumlah deret dalam kelas
+	TotalSum float64 // Total hasil jumlah
+	NSum     int     // Total banyak suku
+}
+
+// add records one processed series in the class summary
+func (c *ClassSummary) add(calc *GeometricCalculator, sum float64) {
+	c.Count++
+	c.TotalSum += sum
+	c.NSum += calc.n
+}
+
+// parseBatchLine parses "a r n" separated by whitespace or commas. Unlike interactive input,
+// a negative ratio is accepted so oscillating series can be included in a batch.
+func parseBatchLine(line string) (*GeometricCalculator, error) {
+	fields := strings.FieldsFunc(line, func(c rune) bool {
+		return c == ',' || c == ' ' || c == '\t'
+	})
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("diharapkan 3 nilai (a r n), diterima %d", len(fields))
+	}
+	a, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return nil, fmt.Errorf("nilai a tidak valid %q", fields[0])
+	}
+	r, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return nil, fmt.Errorf("nilai r tidak valid %q", fields[1])
+	}
+	n, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return nil, fmt.Errorf("nilai n tidak valid %q", fields[2])
+	}
+	if err := checkParams(a, math.Abs(r), n); err != nil {
+		return nil, err
+	}
+	return &GeometricCalculator{a: a, r: r, n: n}, nil
+}
+
+// RunBatch reads one parameter set per line from in and writes each sum to out as soon as
+// its line arrives. Blank lines and lines starting with # are skipped.
+func RunBatch(in io.Reader, out io.Writer) (BatchSummary, error) {
+	var summary BatchSummary
+	scanner := bufio.NewScanner(in)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		calc, err := parseBatchLine(line)
+		if err != nil {
+			summary.Failed++
+			fmt.Fprintf(out, "error baris %d: %v\n", lineNo, err)
+			continue
+		}
+		summary.Processed++
+		sum := calc.GeometricSumFormula()
+		summary.Classes[classifyRatio(calc.r)].add(calc, sum)
+		fmt.Fprintf(out, "a=%g r=%g n=%d sum=%s\n",
+			calc.a, calc.r, calc.n, strconv.FormatFloat(sum, 'g', -1, 64))
+	}
+	if err := scanner.Err(); err != nil {
+		return summary, err
+	}
+	fmt.Fprintf(out, "# diproses: %d, gagal: %d\n", summary.Processed, summary.Failed)
+	for class, c := range summary.Classes {
+		if c.Count == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "# %s: %d deret, rata-rata jumlah %g, rata-rata n %g\n",
+			ConvergenceClass(class), c.Count, c.TotalSum/float64(c.Count), float64(c.NSum)/float64(c.Count))
+	}
+	return summary, nil
+}
+
+// BatchProgram runs RunBatch over the file at path, or over stdin when path is "-".
+// A named pipe can be given as path to process lines as another process writes them.
+func BatchProgram(path string) error {
+	in := os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("gagal membuka %s: %v", path, err)
+		}
+		defer f.Close()
+		in = f
+	}
+	_, err := RunBatch(in, os.Stdout)
+	return err
+}
+
+// ListenProgram accepts TCP connections on addr and runs RunBatch on each connection's
+// stream, writing the results back to the same connection until it closes
+func ListenProgram(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("gagal mendengarkan di %s: %v", addr, err)
+	}
+	defer ln.Close()
+	fmt.Printf("Mendengarkan di %s\n", ln.Addr())
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return fmt.Errorf("gagal menerima koneksi: %v", err)
+		}
+		go func(conn net.Conn) {
+			defer conn.Close()
+			summary, err := RunBatch(conn, conn)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: koneksi %s: %v\n", conn.RemoteAddr(), err)
+				return
+			}
+			fmt.Printf("Koneksi %s selesai: %d diproses, %d gagal\n", conn.RemoteAddr(), summary.Processed, summary.Failed)
+		}(conn)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// goFloat formats v as a Go float literal that parses back to exactly v
+func goFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eEnI") {
+		s += ".0"
+	}
+	return s
+}
+
+// GenerateGoSnippet returns a standalone Go program that computes the same closed-form sum as
+// calc.GeometricSumFormula with a, r and n substituted as constants
+func GenerateGoSnippet(calc *GeometricCalculator) string {
+	var b strings.Builder
+	b.WriteString("package main\n\n")
+	if calc.formulaUsesShortcut() {
+		b.WriteString("import \"fmt\"\n\n")
+	} else {
+		b.WriteString("import (\n\t\"fmt\"\n\t\"math\"\n)\n\n")
+	}
+	b.WriteString("func main() {\n")
+	// Variables rather than constants keep the arithmetic in float64 instead of exact constant math
+	if calc.formulaUsesShortcut() {
+		fmt.Fprintf(&b, "\t// r = %s: setiap suku sama dengan a\n", goFloat(calc.r))
+		fmt.Fprintf(&b, "\tvar (\n\t\ta = %s\n\t\tn = %d\n\t)\n", goFloat(calc.a), calc.n)
+		b.WriteString("\tsum := a * float64(n)\n")
+	} else {
+		fmt.Fprintf(&b, "\tvar (\n\t\ta = %s\n\t\tr = %s\n\t\tn = %d\n\t)\n", goFloat(calc.a), goFloat(calc.r), calc.n)
+		b.WriteString("\tsum := a * (1 - math.Pow(r, float64(n))) / (1 - r)\n")
+	}
+	b.WriteString("\tfmt.Println(sum)\n}\n")
+	return b.String()
+}
+
+// formulaUsesShortcut reports whether GeometricSumFormula takes the a·n shortcut for r = 1
+func (g *GeometricCalculator) formulaUsesShortcut() bool {
+	_, shortcut := g.formulaWithEpsilon(epsilon)
+	return shortcut
+}
+
+// GoSnippetProgram prints the generated Go program for the entered series
+func GoSnippetProgram() {
+	fmt.Println("\n=== Kode Go untuk Deret Ini ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	fmt.Printf("\n%s\n", GenerateGoSnippet(calc))
+	fmt.Printf("Hasil yang diharapkan: %s\n", strconv.FormatFloat(calc.GeometricSumFormula(), 'g', -1, 64))
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+)
+
+// singleShotResult is the timing of one cold call of each method, reported by a
+// -single-shot subprocess as JSON on stdout
+type singleShotResult struct {
+	IterativeTime    float64 `json:"iterative_ns"`
+	RecursiveTime    float64 `json:"recursive_ns"`
+	FormulaTime      float64 `json:"formula_ns"`
+	RecursiveSkipped bool    `json:"recursive_skipped"`
+}
+
+// timeOnce measures a single call of f in nanoseconds, without any warm-up
+func timeOnce(f func()) float64 {
+	start := time.Now()
+	f()
+	return float64(time.Since(start).Nanoseconds())
+}
+
+// SingleShotProgram times exactly one call of each method in a fresh process and writes
+// the timings as JSON, for aggregation by ColdBenchmarkProgram
+func SingleShotProgram(calc *GeometricCalculator, cfg BenchmarkConfig) error {
+	var res singleShotResult
+	res.IterativeTime = timeOnce(func() { calc.GeometricSumIterative() })
+	if calc.n > cfg.MaxRecursionDepth {
+		res.RecursiveSkipped = true
+	} else {
+		res.RecursiveTime = timeOnce(func() { calc.GeometricSumRecursive() })
+	}
+	res.FormulaTime = timeOnce(func() { calc.GeometricSumFormula() })
+	return json.NewEncoder(os.Stdout).Encode(res)
+}
+
+// runSingleShot starts this program again in -single-shot mode and decodes its timings
+func runSingleShot(exe string, calc *GeometricCalculator, cfg BenchmarkConfig) (singleShotResult, error) {
+	var res singleShotResult
+	out, err := exec.Command(exe, "-single-shot",
+		"-a", strconv.FormatFloat(calc.a, 'g', -1, 64),
+		"-r", strconv.FormatFloat(calc.r, 'g', -1, 64),
+		"-n", strconv.Itoa(calc.n),
+		"-max-depth", strconv.Itoa(cfg.MaxRecursionDepth),
+	).Output()
+	if err != nil {
+		return res, fmt.Errorf("subproses gagal: %v", err)
+	}
+	if err := json.Unmarshal(out, &res); err != nil {
+		return res, fmt.Errorf("keluaran subproses tidak valid: %v", err)
+	}
+	return res, nil
+}
+
+// ColdBenchmarkProgram measures each method in cfg.Runs fresh subprocesses so that no
+// warm-up from earlier measurements affects the timings, then prints the averages
+func ColdBenchmarkProgram(calc *GeometricCalculator, cfg BenchmarkConfig) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("tidak dapat menemukan executable program: %v", err)
+	}
+
+	fmt.Println("\n=== Tolok Ukur Dingin (satu subproses per pengukuran) ===")
+	var total singleShotResult
+	recursiveRuns := 0
+	for i := 0; i < cfg.Runs; i++ {
+		res, err := runSingleShot(exe, calc, cfg)
+		if err != nil {
+			return err
+		}
+		recursive := "dilewati"
+		if !res.RecursiveSkipped {
+			recursive = fmt.Sprintf("%.0f ns", res.RecursiveTime)
+			total.RecursiveTime += res.RecursiveTime
+			recursiveRuns++
+		}
+		fmt.Printf("Proses %d: iteratif=%.0f ns rekursif=%s rumus=%.0f ns\n",
+			i+1, res.IterativeTime, recursive, res.FormulaTime)
+		total.IterativeTime += res.IterativeTime
+		total.FormulaTime += res.FormulaTime
+	}
+
+	fmt.Printf("\nRata-rata dari %d proses:\n", cfg.Runs)
+	fmt.Printf("Iteratif: %.3f ns\n", total.IterativeTime/float64(cfg.Runs))
+	if recursiveRuns == 0 {
+		fmt.Printf("Rekursif: dilewati (n=%d melebihi batas kedalaman rekursi %d)\n", calc.n, cfg.MaxRecursionDepth)
+	} else {
+		fmt.Printf("Rekursif: %.3f ns\n", total.RecursiveTime/float64(recursiveRuns))
+	}
+	fmt.Printf("Rumus: %.3f ns\n", total.FormulaTime/float64(cfg.Runs))
+	return nil
+}
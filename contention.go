@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+// startContention launches workers goroutines that keep the CPUs and the allocator busy until
+// the returned stop function is called. stop waits for every worker to exit.
+func startContention(workers int) (stop func()) {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var sink []float64
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				// Short-lived allocations keep the garbage collector working as well
+				sink = make([]float64, 64)
+				for j := range sink {
+					sink[j] = float64(j) * 1.0001
+				}
+			}
+		}()
+	}
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
+
+// ContentionProgram runs the comparison on an idle system and again with workers background
+// goroutines, and reports how much each method's timing degrades under the load
+func ContentionProgram(calc *GeometricCalculator, cfg BenchmarkConfig, workers int) error {
+	if workers <= 0 {
+		return fmt.Errorf("-contention harus > 0")
+	}
+
+	fmt.Println("Mengukur tanpa beban...")
+	idle := runComparison(calc, cfg)
+	fmt.Printf("Mengukur dengan %d goroutine latar belakang...\n", workers)
+	stop := startContention(workers)
+	// The cached overhead was calibrated idle; recalibrate it under the same load
+	loaded := runComparisonWith(calc, cfg, func() float64 { return cfg.averageTime(func() {}) })
+	stop()
+
+	fmt.Printf("\n%-10s %-18s %-18s %s\n", "Metode", "Tanpa beban (ns)", "Dengan beban (ns)", "Perlambatan")
+	for _, row := range []struct {
+		label        string
+		idle, loaded float64
+		skipped      bool
+	}{
+		{"Iteratif", idle.IterativeTime, loaded.IterativeTime, false},
+		{"Rekursif", idle.RecursiveTime, loaded.RecursiveTime, idle.RecursiveSkipped},
+		{"Rumus", idle.FormulaTime, loaded.FormulaTime, false},
+	} {
+		if row.skipped {
+			fmt.Printf("%-10s dilewati (n=%d melebihi batas kedalaman rekursi %d)\n", row.label, calc.n, cfg.MaxRecursionDepth)
+			continue
+		}
+		slowdown := "n/a"
+		if row.idle > 0 {
+			slowdown = fmt.Sprintf("%.2fx", row.loaded/row.idle)
+		}
+		fmt.Printf("%-10s %-18.3f %-18.3f %s\n", row.label, row.idle, row.loaded, slowdown)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// ConvergenceClass describes the long-run behaviour of a geometric series
+type ConvergenceClass int
+
+const (
+	Convergent  ConvergenceClass = iota // 0 <= r < 1: jumlah menuju limit
+	Constant                            // r = 1: setiap suku sama
+	Divergent                           // r > 1: jumlah tumbuh tanpa batas
+	Oscillating                         // r < 0: tanda suku berganti-ganti
+	numConvergenceClasses
+)
+
+// String returns the display name of the convergence class
+func (c ConvergenceClass) String() string {
+	switch c {
+	case Convergent:
+		return "konvergen"
+	case Constant:
+		return "konstan"
+	case Divergent:
+		return "divergen"
+	case Oscillating:
+		return "berosilasi"
+	default:
+		return "tidak dikenal"
+	}
+}
+
+// classifyRatio returns the convergence class of a series with ratio r. A negative ratio is
+// classed as oscillating regardless of its magnitude.
+func classifyRatio(r float64) ConvergenceClass {
+	switch {
+	case r < 0:
+		return Oscillating
+	case math.Abs(r-1.0) < epsilon:
+		return Constant
+	case r < 1:
+		return Convergent
+	default:
+		return Divergent
+	}
+}
+
+// ConvergenceReport gathers the convergence analyses of a series in one place
+type ConvergenceReport struct {
+	Class           ConvergenceClass // Kelas konvergensi menurut rasio
+	Converges       bool             // Jumlah tak hingga ada (|r| < 1)
+	Limit           float64          // Jumlah tak hingga; 0 jika tidak konvergen
+	TruncationError float64          // Limit dikurangi jumlah n suku; 0 jika tidak konvergen
+	FractionOfLimit float64          // Bagian limit yang tercakup n suku (0-1); 0 jika tidak konvergen
+	EffectiveCount  int              // Suku yang masih mengubah jumlah pada presisi float64
+}
+
+// ConvergenceInfo computes the full convergence report for the series in one call
+func (g *GeometricCalculator) ConvergenceInfo() ConvergenceReport {
+	report := ConvergenceReport{Class: classifyRatio(g.r)}
+	_, report.EffectiveCount = g.SumWithEffectiveCount(machineEpsilon)
+
+	if limit, err := g.GeometricSumInfinite(); err == nil {
+		report.Converges = true
+		report.Limit = limit
+		report.TruncationError = g.a * math.Pow(g.r, float64(g.n)) / (1 - g.r)
+		if percent, err := g.PercentOfLimit(); err == nil {
+			report.FractionOfLimit = percent / 100
+		}
+	}
+	return report
+}
+
+// ConvergenceInfoProgram prints the convergence report of a series
+func ConvergenceInfoProgram() {
+	fmt.Println("\n=== Ringkasan Konvergensi ===")
+	a, r, n, err := signedSeries.read(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	report := (&GeometricCalculator{a: a, r: r, n: n}).ConvergenceInfo()
+	fmt.Printf("\nKelas: %s\n", report.Class)
+	if report.Converges {
+		fmt.Printf("Jumlah tak hingga: %.10g\n", report.Limit)
+		fmt.Printf("Galat pemotongan pada n=%d: %.3e\n", n, report.TruncationError)
+		fmt.Printf("Bagian limit yang tercakup: %.6g%%\n", report.FractionOfLimit*100)
+	} else {
+		fmt.Println("Jumlah tak hingga: tidak ada (|r| >= 1)")
+	}
+	fmt.Printf("Suku efektif pada presisi float64: %d dari %d\n", report.EffectiveCount, n)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "modernc.org/sqlite" // Driver SQLite murni Go, terdaftar dengan nama "sqlite"
+)
+
+// sqliteDriver is the database/sql driver name used for the results database
+const sqliteDriver = "sqlite"
+
+const createResultsTable = `CREATE TABLE IF NOT EXISTS results (
+	id             INTEGER PRIMARY KEY AUTOINCREMENT,
+	timestamp      TEXT    NOT NULL,
+	a              REAL    NOT NULL,
+	r              REAL    NOT NULL,
+	n              INTEGER NOT NULL,
+	iterative      REAL    NOT NULL,
+	recursive      REAL    NOT NULL,
+	formula        REAL    NOT NULL,
+	iterative_ns      REAL    NOT NULL,
+	recursive_ns      REAL    NOT NULL,
+	formula_ns        REAL    NOT NULL DEFAULT 0,
+	recursive_skipped INTEGER NOT NULL DEFAULT 0
+)`
+
+// addedResultColumns are the columns added to results after its first release, with their
+// definitions, so databases created by older builds can be upgraded in place
+var addedResultColumns = []struct{ name, definition string }{
+	{"formula_ns", "REAL NOT NULL DEFAULT 0"},
+	{"recursive_skipped", "INTEGER NOT NULL DEFAULT 0"},
+}
+
+// migrateResultsTable adds any column of addedResultColumns missing from an existing results table
+func migrateResultsTable(db *sql.DB) error {
+	rows, err := db.Query("SELECT name FROM pragma_table_info('results')")
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return err
+		}
+		existing[name] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, col := range addedResultColumns {
+		if existing[col.name] {
+			continue
+		}
+		if _, err := db.Exec("ALTER TABLE results ADD COLUMN " + col.name + " " + col.definition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// openResultDB opens or creates the SQLite database at path and ensures the schema exists
+func openResultDB(path string) (*sql.DB, error) {
+	db, err := sql.Open(sqliteDriver, path)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membuka database %s: %v", path, err)
+	}
+	if _, err := db.Exec(createResultsTable); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("gagal membuat tabel results: %v", err)
+	}
+	if err := migrateResultsTable(db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("gagal memperbarui tabel results: %v", err)
+	}
+	return db, nil
+}
+
+// InsertResult stores the parameters and results of one run in the results table
+func InsertResult(db *sql.DB, r Result) error {
+	_, err := db.Exec(
+		`INSERT INTO results (timestamp, a, r, n, iterative, recursive, formula,
+			iterative_ns, recursive_ns, formula_ns, recursive_skipped)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		r.Timestamp.Format(time.RFC3339), r.A, r.R, r.N,
+		r.Iterative, r.Recursive, r.Formula, r.IterativeTime, r.RecursiveTime, r.FormulaTime, r.RecursiveSkipped,
+	)
+	return err
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// iterativeSumFunc is GeometricSumIterative as a plain function taking the calculator
+func iterativeSumFunc(g *GeometricCalculator) float64 {
+	sum, term := 0.0, g.a
+	for i := 0; i < g.n; i++ {
+		sum += term
+		term *= g.r
+	}
+	return sum
+}
+
+// formulaSumFunc is GeometricSumFormula as a plain function taking the calculator
+func formulaSumFunc(g *GeometricCalculator) float64 {
+	if math.Abs(g.r-1.0) < epsilon {
+		return g.a * float64(g.n)
+	}
+	return g.a * (1 - math.Pow(g.r, float64(g.n))) / (1 - g.r)
+}
+
+// DispatchProgram times the same computation invoked as a direct method call, a method value,
+// a method expression (as the method registry does) and a plain function value
+func DispatchProgram() {
+	fmt.Println("\n=== Biaya Pemanggilan: Metode vs Fungsi ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	var sink float64
+	for _, group := range []struct {
+		label      string
+		method     func() float64
+		expression func(*GeometricCalculator) float64
+		function   func(*GeometricCalculator) float64
+		direct     func()
+	}{
+		{"Iteratif", calc.GeometricSumIterative, (*GeometricCalculator).GeometricSumIterative, iterativeSumFunc,
+			func() { sink = calc.GeometricSumIterative() }},
+		{"Rumus", calc.GeometricSumFormula, (*GeometricCalculator).GeometricSumFormula, formulaSumFunc,
+			func() { sink = calc.GeometricSumFormula() }},
+	} {
+		fmt.Printf("\n%s:\n", group.label)
+		fmt.Printf("  %-28s %.3f ns\n", "Panggilan metode langsung", averageTime(group.direct))
+		fmt.Printf("  %-28s %.3f ns\n", "Nilai metode (calc.F)", averageTime(func() { sink = group.method() }))
+		fmt.Printf("  %-28s %.3f ns\n", "Ekspresi metode ((*T).F)", averageTime(func() { sink = group.expression(calc) }))
+		fmt.Printf("  %-28s %.3f ns\n", "Fungsi biasa (f(calc))", averageTime(func() { sink = group.function(calc) }))
+	}
+	_ = sink
+}
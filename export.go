@@ -0,0 +1,45 @@
+package main
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+// resultColumns is the header shared by the CSV and TSV exports of a Result
+var resultColumns = []string{
+	"timestamp", "a", "r", "n",
+	"iterative", "recursive", "formula",
+	"iterative_ns", "recursive_ns", "formula_ns", "recursive_skipped",
+}
+
+// resultRecord converts r into one row matching resultColumns
+func resultRecord(r Result) []string {
+	f := func(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) }
+	return []string{
+		r.Timestamp.Format(time.RFC3339), f(r.A), f(r.R), strconv.Itoa(r.N),
+		f(r.Iterative), f(r.Recursive), f(r.Formula),
+		f(r.IterativeTime), f(r.RecursiveTime), f(r.FormulaTime), strconv.FormatBool(r.RecursiveSkipped),
+	}
+}
+
+// writeResultsDelimited writes the header and r as one row separated by sep
+func writeResultsDelimited(w io.Writer, r Result, sep rune) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = sep
+	cw.Write(resultColumns)
+	cw.Write(resultRecord(r))
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteResultsCSV writes r as comma-separated values with a header row
+func WriteResultsCSV(w io.Writer, r Result) error {
+	return writeResultsDelimited(w, r, ',')
+}
+
+// WriteResultsTSV writes r as tab-separated values with the same columns as WriteResultsCSV
+func WriteResultsTSV(w io.Writer, r Result) error {
+	return writeResultsDelimited(w, r, '\t')
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// displayOptions controls how numbers in result output are presented
+type displayOptions struct {
+	Locale   string // Konvensi angka: "" (apa adanya), "id", atau "en"
+	Currency string // Simbol mata uang untuk hasil jumlah; kosong berarti bukan nominal uang
+
+	Reliability bool // Tampilkan penilaian keandalan hasil (tiga lintasan O(n) tambahan)
+}
+
+// localeSeparators returns the thousands and decimal separators of locale
+func localeSeparators(locale string) (thousands, decimal string, err error) {
+	switch locale {
+	case "id":
+		return ".", ",", nil
+	case "en":
+		return ",", ".", nil
+	default:
+		return "", "", fmt.Errorf("locale angka tidak dikenal: %s (gunakan id atau en)", locale)
+	}
+}
+
+// formatNumber formats v with two decimals using the grouping convention of locale,
+// e.g. "1.234,56" for id and "1,234.56" for en
+func formatNumber(v float64, locale string) string {
+	return formatNumberPrec(v, 2, locale)
+}
+
+// formatNumberPrec formats v with prec decimals using the grouping convention of locale.
+// An empty or unknown locale formats without grouping.
+func formatNumberPrec(v float64, prec int, locale string) string {
+	plain := strconv.FormatFloat(v, 'f', prec, 64)
+	thousands, decimal, err := localeSeparators(locale)
+	if err != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+		return plain
+	}
+
+	sign := ""
+	if strings.HasPrefix(plain, "-") {
+		sign, plain = "-", plain[1:]
+	}
+	intPart, fracPart, hasFrac := strings.Cut(plain, ".")
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, digit := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteString(thousands)
+		}
+		b.WriteRune(digit)
+	}
+	if hasFrac {
+		b.WriteString(decimal)
+		b.WriteString(fracPart)
+	}
+	return b.String()
+}
+
+// number formats v with prec decimals according to the display locale
+func (d displayOptions) number(v float64, prec int) string {
+	return formatNumberPrec(v, prec, d.Locale)
+}
+
+// amount formats a monetary result: with the currency symbol, grouping and two decimals when
+// a currency is set (grouping per the display locale, id by default), and like number otherwise
+func (d displayOptions) amount(v float64, prec int) string {
+	if d.Currency == "" {
+		return d.number(v, prec)
+	}
+	locale := d.Locale
+	if locale == "" {
+		locale = "id"
+	}
+	return d.Currency + " " + formatNumber(v, locale)
+}
+
+// parseCurrency parses an amount such as "Rp 1.000,50", "1,000.50" or "1000.50", ignoring any
+// currency symbol. When both separators appear the last one is the decimal separator. A repeated
+// separator is thousands grouping, and so is a lone one followed by exactly three digits, unless
+// the digits before it cannot lead a group (empty, a leading zero, or more than three digits).
+func parseCurrency(s string) (float64, error) {
+	var b strings.Builder
+	for _, c := range s {
+		if (c >= '0' && c <= '9') || c == '.' || c == ',' || c == '-' {
+			b.WriteRune(c)
+		}
+	}
+	digits := b.String()
+	if digits == "" {
+		return 0, fmt.Errorf("nominal tidak valid: %q", s)
+	}
+
+	lastDot, lastComma := strings.LastIndex(digits, "."), strings.LastIndex(digits, ",")
+	decimal := ""
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		decimal = "."
+		if lastComma > lastDot {
+			decimal = ","
+		}
+	case lastDot >= 0 || lastComma >= 0:
+		sep := "."
+		if lastComma >= 0 {
+			sep = ","
+		}
+		last := strings.LastIndex(digits, sep)
+		lead := strings.TrimPrefix(digits[:last], "-")
+		leadsGroup := lead != "" && lead[0] != '0' && len(lead) <= 3
+		if strings.Count(digits, sep) == 1 && (len(digits)-last-1 != 3 || !leadsGroup) {
+			decimal = sep
+		}
+	}
+
+	var intPart, fracPart string
+	if decimal != "" {
+		i := strings.LastIndex(digits, decimal)
+		intPart, fracPart = digits[:i], digits[i+1:]
+	} else {
+		intPart = digits
+	}
+	intPart = strings.NewReplacer(".", "", ",", "").Replace(intPart)
+	normalized := intPart
+	if fracPart != "" {
+		normalized += "." + fracPart
+	}
+
+	v, err := strconv.ParseFloat(normalized, 64)
+	if err != nil {
+		return 0, fmt.Errorf("nominal tidak valid: %q", s)
+	}
+	return v, nil
+}
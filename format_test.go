@@ -0,0 +1,47 @@
+package main
+
+import "testing"
+
+func TestParseCurrency(t *testing.T) {
+	tests := []struct {
+		in   string
+		want float64
+	}{
+		{"1000", 1000},
+		{"Rp 500", 500},
+		{"1.000", 1000},
+		{"12,345", 12345},
+		{"1.234.567", 1234567},
+		{"1,234,567", 1234567},
+		{"Rp 1.000,50", 1000.5},
+		{"Rp. 1.000,50", 1000.5},
+		{"1,000.50", 1000.5},
+		{"1000.50", 1000.5},
+		{"1000,5", 1000.5},
+		{"$ 2,500.75", 2500.75},
+		{"-1.500,25", -1500.25},
+		// A lone separator with three digits after it is grouping only when what precedes it can lead a group
+		{"0.125", 0.125},
+		{"-0,125", -0.125},
+		{"1234.567", 1234.567},
+		{"3,141", 3141},
+	}
+	for _, tt := range tests {
+		got, err := parseCurrency(tt.in)
+		if err != nil {
+			t.Errorf("parseCurrency(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseCurrency(%q) = %v; want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseCurrencyRejectsInvalid(t *testing.T) {
+	for _, in := range []string{"", "Rp", "abc", "--5", "1-2"} {
+		if v, err := parseCurrency(in); err == nil {
+			t.Errorf("parseCurrency(%q) = %v; want an error", in, v)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+// ResultHash computes the iterative, recursive and formula results and returns an FNV-1a hash
+// of their bit patterns, so any numeric drift between builds changes the hash. When n exceeds
+// maxDepth the recursive result is hashed as NaN.
+func ResultHash(calc *GeometricCalculator, maxDepth int) uint64 {
+	recursive := math.NaN()
+	if calc.n <= maxDepth {
+		recursive = calc.GeometricSumRecursive()
+	}
+
+	h := fnv.New64a()
+	var buf [8]byte
+	for _, v := range []float64{calc.GeometricSumIterative(), recursive, calc.GeometricSumFormula()} {
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(v))
+		h.Write(buf[:])
+	}
+	return h.Sum64()
+}
+
+// HashProgram prints the result hash for calc as 16 hexadecimal digits
+func HashProgram(calc *GeometricCalculator, cfg BenchmarkConfig) error {
+	_, err := fmt.Printf("%016x\n", ResultHash(calc, cfg.MaxRecursionDepth))
+	return err
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxChartPoints = 200 // Jumlah titik terbanyak pada grafik jumlah parsial
+	chartWidth     = 600 // Lebar area grafik SVG (px)
+	chartHeight    = 300 // Tinggi area grafik SVG (px)
+	chartMargin    = 40  // Margin di sekeliling area plot (px)
+)
+
+// htmlReportRow is one method row of the HTML results table
+type htmlReportRow struct {
+	Label  string
+	Result string
+	TimeNs string
+}
+
+// htmlReportData is the data rendered by htmlReportTemplate
+type htmlReportData struct {
+	Res       Result
+	Rows      []htmlReportRow
+	Points    string // Titik polyline jumlah parsial dalam koordinat SVG
+	LimitY    string // Koordinat y garis limit; kosong jika deret tidak konvergen
+	Limit     string
+	YMin      string
+	YMax      string
+	Width     int
+	Height    int
+	Margin    int
+	PlotRight int
+	PlotBase  int
+}
+
+var htmlReportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="id">
+<head>
+<meta charset="utf-8">
+<title>Laporan Deret Geometri</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #ccc; padding: 4px 10px; text-align: right; }
+th { background: #f0f0f0; }
+td:first-child, th:first-child { text-align: left; }
+</style>
+</head>
+<body>
+<h1>Laporan Deret Geometri</h1>
+<h2>Parameter</h2>
+<table>
+<tr><th>Parameter</th><th>Nilai</th></tr>
+<tr><td>Suku pertama (a)</td><td>{{.Res.A}}</td></tr>
+<tr><td>Rasio (r)</td><td>{{.Res.R}}</td></tr>
+<tr><td>Jumlah suku (n)</td><td>{{.Res.N}}</td></tr>
+<tr><td>Waktu pengujian</td><td>{{.Res.Timestamp.Format "2006-01-02 15:04:05"}}</td></tr>
+</table>
+<h2>Hasil</h2>
+<table>
+<tr><th>Metode</th><th>Hasil</th><th>Waktu (ns)</th></tr>
+{{range .Rows}}<tr><td>{{.Label}}</td><td>{{.Result}}</td><td>{{.TimeNs}}</td></tr>
+{{end}}</table>
+<h2>Konvergensi Jumlah Parsial</h2>
+<svg xmlns="http://www.w3.org/2000/svg" width="{{.Width}}" height="{{.Height}}" viewBox="0 0 {{.Width}} {{.Height}}">
+<rect x="0" y="0" width="{{.Width}}" height="{{.Height}}" fill="#fff"/>
+<line x1="{{.Margin}}" y1="{{.PlotBase}}" x2="{{.PlotRight}}" y2="{{.PlotBase}}" stroke="#888"/>
+<line x1="{{.Margin}}" y1="{{.Margin}}" x2="{{.Margin}}" y2="{{.PlotBase}}" stroke="#888"/>
+<text x="{{.Margin}}" y="{{.Margin}}" dx="-4" text-anchor="end" font-size="11">{{.YMax}}</text>
+<text x="{{.Margin}}" y="{{.PlotBase}}" dx="-4" text-anchor="end" font-size="11">{{.YMin}}</text>
+<text x="{{.Margin}}" y="{{.PlotBase}}" dy="16" font-size="11">1</text>
+<text x="{{.PlotRight}}" y="{{.PlotBase}}" dy="16" text-anchor="end" font-size="11">{{.Res.N}}</text>
+{{if .LimitY}}<line x1="{{.Margin}}" y1="{{.LimitY}}" x2="{{.PlotRight}}" y2="{{.LimitY}}" stroke="#c33" stroke-dasharray="6 4"/>
+<text x="{{.PlotRight}}" y="{{.LimitY}}" dy="-4" text-anchor="end" font-size="11" fill="#c33">limit {{.Limit}}</text>
+{{end}}<polyline points="{{.Points}}" fill="none" stroke="#36c" stroke-width="2"/>
+</svg>
+</body>
+</html>
+`))
+
+// chartPartialSums returns up to maxChartPoints (k, S_k) pairs spread evenly over 1..n.
+// Non-finite partial sums are dropped.
+func chartPartialSums(calc *GeometricCalculator) (ks []int, sums []float64) {
+	step := 1
+	if calc.n > maxChartPoints {
+		step = (calc.n + maxChartPoints - 1) / maxChartPoints
+	}
+	sum, term := 0.0, calc.a
+	for k := 1; k <= calc.n; k++ {
+		sum += term
+		term *= calc.r
+		if (k%step == 0 || k == 1 || k == calc.n) && !math.IsInf(sum, 0) && !math.IsNaN(sum) {
+			ks = append(ks, k)
+			sums = append(sums, sum)
+		}
+	}
+	return ks, sums
+}
+
+// WriteHTMLReport saves a self-contained HTML page with the parameters, the results table
+// and an inline SVG chart of the partial sums converging toward the limit
+func (res Result) WriteHTMLReport(path string) error {
+	calc := &GeometricCalculator{a: res.A, r: res.R, n: res.N}
+	data := htmlReportData{
+		Res:       res,
+		Width:     chartWidth,
+		Height:    chartHeight,
+		Margin:    chartMargin,
+		PlotRight: chartWidth - chartMargin,
+		PlotBase:  chartHeight - chartMargin,
+	}
+
+	format := func(v float64) string { return strconv.FormatFloat(v, 'g', 10, 64) }
+	data.Rows = append(data.Rows, htmlReportRow{"Iteratif", format(res.Iterative), format(res.IterativeTime)})
+	if res.RecursiveSkipped {
+		data.Rows = append(data.Rows, htmlReportRow{"Rekursif", "dilewati", "-"})
+	} else {
+		data.Rows = append(data.Rows, htmlReportRow{"Rekursif", format(res.Recursive), format(res.RecursiveTime)})
+	}
+	data.Rows = append(data.Rows, htmlReportRow{"Rumus", format(res.Formula), format(res.FormulaTime)})
+
+	ks, sums := chartPartialSums(calc)
+	limit, limitErr := calc.GeometricSumInfinite()
+	lo, hi := math.Min(0, res.A), math.Max(0, res.A)
+	for _, s := range sums {
+		lo, hi = math.Min(lo, s), math.Max(hi, s)
+	}
+	if limitErr == nil {
+		lo, hi = math.Min(lo, limit), math.Max(hi, limit)
+	}
+	if hi == lo {
+		hi = lo + 1
+	}
+	data.YMin, data.YMax = format(lo), format(hi)
+
+	plotW := float64(data.PlotRight - data.Margin)
+	plotH := float64(data.PlotBase - data.Margin)
+	y := func(v float64) float64 { return float64(data.PlotBase) - (v-lo)/(hi-lo)*plotH }
+	points := make([]string, len(ks))
+	for i, k := range ks {
+		x := float64(data.Margin)
+		if res.N > 1 {
+			x += float64(k-1) / float64(res.N-1) * plotW
+		}
+		points[i] = fmt.Sprintf("%.1f,%.1f", x, y(sums[i]))
+	}
+	data.Points = strings.Join(points, " ")
+	if limitErr == nil {
+		data.LimitY = fmt.Sprintf("%.1f", y(limit))
+		data.Limit = format(limit)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("gagal membuat laporan HTML %s: %v", path, err)
+	}
+	if err := htmlReportTemplate.Execute(f, data); err != nil {
+		f.Close()
+		return fmt.Errorf("gagal menulis laporan HTML %s: %v", path, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// lineReader reads user input one line at a time. All prompts share a single instance so
+// no leftover newline from one prompt is consumed by the next.
+type lineReader struct {
+	r        *bufio.Reader
+	currency bool // Baca nilai a sebagai nominal mata uang, mis. "Rp 1.000,50"
+}
+
+// stdin is the shared reader for every interactive prompt
+var stdin = newLineReader(os.Stdin)
+
+// newLineReader wraps r in a buffered line reader
+func newLineReader(r io.Reader) *lineReader {
+	return &lineReader{r: bufio.NewReader(r)}
+}
+
+// readLine returns the next line without surrounding whitespace. A final line without a
+// trailing newline is still returned; io.EOF is reported only when no input remains.
+func (lr *lineReader) readLine() (string, error) {
+	line, err := lr.r.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	return strings.TrimSpace(line), err
+}
+
+// readFloat reads the next line and parses it into dst
+func (lr *lineReader) readFloat(dst *float64) error {
+	line, err := lr.readLine()
+	if err != nil {
+		return err
+	}
+	*dst, err = strconv.ParseFloat(line, 64)
+	return err
+}
+
+// readAmount reads the next line into dst, parsing it as a currency amount when the reader
+// is in currency mode and as a plain float otherwise
+func (lr *lineReader) readAmount(dst *float64) error {
+	if !lr.currency {
+		return lr.readFloat(dst)
+	}
+	line, err := lr.readLine()
+	if err != nil {
+		return err
+	}
+	*dst, err = parseCurrency(line)
+	return err
+}
+
+// readInt reads the next line and parses it into dst
+func (lr *lineReader) readInt(dst *int) error {
+	line, err := lr.readLine()
+	if err != nil {
+		return err
+	}
+	*dst, err = strconv.Atoi(line)
+	return err
+}
+
+// readInt64 reads the next line and parses it into dst
+func (lr *lineReader) readInt64(dst *int64) error {
+	line, err := lr.readLine()
+	if err != nil {
+		return err
+	}
+	*dst, err = strconv.ParseInt(line, 10, 64)
+	return err
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+	"time"
+)
+
+// ManifestInputs records the parameters of the geometric sequence
+type ManifestInputs struct {
+	A float64 `json:"a"`
+	R float64 `json:"r"`
+	N int     `json:"n"`
+}
+
+// ManifestBenchmark records the benchmark settings in effect for the run
+type ManifestBenchmark struct {
+	Runs              int  `json:"runs"`
+	WarmUpRuns        int  `json:"warm_up_runs"`
+	Iterations        int  `json:"iterations"`
+	MaxRecursionDepth int  `json:"max_recursion_depth"`
+	SubtractOverhead  bool `json:"subtract_overhead"`
+}
+
+// ManifestMachine records the machine and toolchain the run was executed on
+type ManifestMachine struct {
+	NumCPU    int    `json:"num_cpu"`
+	GoVersion string `json:"go_version"`
+	OS        string `json:"os"`
+	Arch      string `json:"arch"`
+}
+
+// Manifest is a self-describing record of one comparison run
+type Manifest struct {
+	Timestamp time.Time         `json:"timestamp"`
+	Inputs    ManifestInputs    `json:"inputs"`
+	Benchmark ManifestBenchmark `json:"benchmark"`
+	Machine   ManifestMachine   `json:"machine"`
+	Results   Result            `json:"results"`
+}
+
+// NewManifest builds a manifest for res, capturing the current machine metadata
+func NewManifest(res Result, cfg BenchmarkConfig) Manifest {
+	return Manifest{
+		Timestamp: time.Now(),
+		Inputs:    ManifestInputs{A: res.A, R: res.R, N: res.N},
+		Benchmark: ManifestBenchmark{
+			Runs:              cfg.Runs,
+			WarmUpRuns:        cfg.WarmUpRuns,
+			Iterations:        cfg.Iterations,
+			MaxRecursionDepth: cfg.MaxRecursionDepth,
+			SubtractOverhead:  cfg.SubtractOverhead,
+		},
+		Machine: ManifestMachine{
+			NumCPU:    runtime.NumCPU(),
+			GoVersion: runtime.Version(),
+			OS:        runtime.GOOS,
+			Arch:      runtime.GOARCH,
+		},
+		Results: res,
+	}
+}
+
+// Config returns the benchmark settings recorded in the manifest
+func (m Manifest) Config() BenchmarkConfig {
+	return BenchmarkConfig{
+		Runs:              m.Benchmark.Runs,
+		WarmUpRuns:        m.Benchmark.WarmUpRuns,
+		Iterations:        m.Benchmark.Iterations,
+		MaxRecursionDepth: m.Benchmark.MaxRecursionDepth,
+		SubtractOverhead:  m.Benchmark.SubtractOverhead,
+	}
+}
+
+// LoadManifest reads a manifest previously written by WriteManifest
+func LoadManifest(path string) (Manifest, error) {
+	var m Manifest
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return m, fmt.Errorf("gagal membaca manifest %s: %v", path, err)
+	}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return m, fmt.Errorf("manifest %s tidak valid: %v", path, err)
+	}
+	if err := checkParams(m.Inputs.A, m.Inputs.R, m.Inputs.N); err != nil {
+		return m, fmt.Errorf("parameter manifest tidak valid: %v", err)
+	}
+	if m.Benchmark.Runs <= 0 || m.Benchmark.Iterations <= 0 || m.Benchmark.WarmUpRuns < 0 || m.Benchmark.MaxRecursionDepth <= 0 {
+		return m, fmt.Errorf("konfigurasi tolok ukur manifest tidak valid")
+	}
+	return m, nil
+}
+
+// ReplayProgram re-runs the inputs and benchmark settings of a saved manifest and compares
+// the fresh results and timings with the recorded ones
+func ReplayProgram(path string) error {
+	m, err := LoadManifest(path)
+	if err != nil {
+		return err
+	}
+
+	calc := &GeometricCalculator{a: m.Inputs.A, r: m.Inputs.R, n: m.Inputs.N}
+	fresh := runComparison(calc, m.Config())
+	old := m.Results
+
+	fmt.Printf("=== Replay %s ===\n", path)
+	fmt.Printf("Direkam:  %s (Go %s, %s/%s, %d CPU)\n",
+		m.Timestamp.Format(time.RFC3339), m.Machine.GoVersion, m.Machine.OS, m.Machine.Arch, m.Machine.NumCPU)
+	fmt.Printf("Sekarang: %s (Go %s, %s/%s, %d CPU)\n",
+		fresh.Timestamp.Format(time.RFC3339), runtime.Version(), runtime.GOOS, runtime.GOARCH, runtime.NumCPU())
+
+	allMatch := true
+	fmt.Printf("\n%-10s %-24s %-24s %s\n", "Hasil", "Direkam", "Sekarang", "Status")
+	for _, row := range []struct {
+		label      string
+		old, fresh float64
+		skipped    bool
+	}{
+		{"Iteratif", old.Iterative, fresh.Iterative, false},
+		{"Rekursif", old.Recursive, fresh.Recursive, old.RecursiveSkipped || fresh.RecursiveSkipped},
+		{"Rumus", old.Formula, fresh.Formula, false},
+	} {
+		status := "sama"
+		switch {
+		case row.skipped:
+			status = "dilewati"
+		case math.Float64bits(row.old) != math.Float64bits(row.fresh):
+			status = "BERBEDA"
+			allMatch = false
+		}
+		fmt.Printf("%-10s %-24.17g %-24.17g %s\n", row.label, row.old, row.fresh, status)
+	}
+
+	fmt.Printf("\n%-10s %-16s %-16s %s\n", "Waktu", "Direkam (ns)", "Sekarang (ns)", "Rasio")
+	for _, row := range []struct {
+		label      string
+		old, fresh float64
+	}{
+		{"Iteratif", old.IterativeTime, fresh.IterativeTime},
+		{"Rekursif", old.RecursiveTime, fresh.RecursiveTime},
+		{"Rumus", old.FormulaTime, fresh.FormulaTime},
+	} {
+		ratio := "-"
+		if row.old > 0 {
+			ratio = fmt.Sprintf("%.2fx", row.fresh/row.old)
+		}
+		fmt.Printf("%-10s %-16.3f %-16.3f %s\n", row.label, row.old, row.fresh, ratio)
+	}
+
+	if allMatch {
+		fmt.Println("\nSemua hasil identik dengan rekaman; perbedaan waktu wajar antar-run dan antar-mesin.")
+	} else {
+		fmt.Println("\nPERINGATAN: hasil berbeda dari rekaman; kode atau lingkungan telah berubah.")
+	}
+	return nil
+}
+
+// WriteManifest writes the manifest as indented JSON to path
+func (m Manifest) WriteManifest(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("gagal menyusun manifest: %v", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("gagal menulis manifest %s: %v", path, err)
+	}
+	return nil
+}
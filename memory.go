@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+)
+
+const memorySweepRuns = 10 // Jumlah pemanggilan yang dirata-ratakan per n
+
+// memorySweepRow holds the average heap allocation of one recursive call at one term count
+type memorySweepRow struct {
+	n             int
+	bytesPerCall  float64
+	allocsPerCall float64
+}
+
+// allocsPerCall runs f memorySweepRuns times and returns the average bytes and allocations
+// per call from runtime.MemStats deltas
+func allocsPerCall(f func()) (bytes, allocs float64) {
+	f() // pemanasan agar alokasi sekali jalan tidak terhitung
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	for i := 0; i < memorySweepRuns; i++ {
+		f()
+	}
+	runtime.ReadMemStats(&after)
+	return float64(after.TotalAlloc-before.TotalAlloc) / memorySweepRuns,
+		float64(after.Mallocs-before.Mallocs) / memorySweepRuns
+}
+
+// runRecursiveMemorySweep measures the allocation of GeometricSumRecursive at n = 10, 100, ...
+// up to maxN, showing the growth of its per-call memo map
+func runRecursiveMemorySweep(maxN int) []memorySweepRow {
+	var rows []memorySweepRow
+	for n := 10; n <= maxN; n *= 10 {
+		calc := &GeometricCalculator{a: 1, r: 0.5, n: n}
+		bytes, allocs := allocsPerCall(func() {
+			calc.GeometricSumRecursive()
+		})
+		rows = append(rows, memorySweepRow{n: n, bytesPerCall: bytes, allocsPerCall: allocs})
+	}
+	return rows
+}
+
+// RecursiveMemoryProgram reports how the recursive method's memory grows with n, next to
+// the allocation-free iterative method
+func RecursiveMemoryProgram(cfg BenchmarkConfig) {
+	fmt.Println("\n=== Pertumbuhan Memori Metode Rekursif ===")
+	fmt.Printf("\n%-10s %-18s %-18s %s\n", "n", "Byte/panggilan", "Alokasi/panggilan", "Byte/suku")
+	for _, row := range runRecursiveMemorySweep(cfg.MaxRecursionDepth) {
+		fmt.Printf("%-10d %-18.0f %-18.1f %.1f\n", row.n, row.bytesPerCall, row.allocsPerCall,
+			row.bytesPerCall/float64(row.n))
+	}
+
+	iterative := &GeometricCalculator{a: 1, r: 0.5, n: cfg.MaxRecursionDepth}
+	bytes, _ := allocsPerCall(func() {
+		iterative.GeometricSumIterative()
+	})
+	fmt.Printf("\nIteratif (n=%d): %.0f byte/panggilan\n", cfg.MaxRecursionDepth, bytes)
+}
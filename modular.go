@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+const maxDirectModularN = 10000000 // Batas n untuk pemeriksaan langsung suku demi suku
+
+// ModularRing is the ring of integers modulo m. Elements are uint64 values in [0, m).
+type ModularRing struct {
+	m uint64 // Modulus
+}
+
+// NewModularRing returns the ring Z/mZ for m >= 2
+func NewModularRing(m uint64) (ModularRing, error) {
+	if m < 2 {
+		return ModularRing{}, fmt.Errorf("modulus harus >= 2")
+	}
+	return ModularRing{m: m}, nil
+}
+
+// Reduce maps x into [0, m)
+func (z ModularRing) Reduce(x uint64) uint64 {
+	return x % z.m
+}
+
+// Add returns (x + y) mod m without overflowing for any modulus
+func (z ModularRing) Add(x, y uint64) uint64 {
+	sum, carry := bits.Add64(x, y, 0)
+	if carry != 0 || sum >= z.m {
+		sum -= z.m
+	}
+	return sum
+}
+
+// Mul returns (x · y) mod m using the full 128-bit product
+func (z ModularRing) Mul(x, y uint64) uint64 {
+	hi, lo := bits.Mul64(x, y)
+	return bits.Rem64(hi, lo, z.m)
+}
+
+// Pow returns x^e mod m by square-and-multiply
+func (z ModularRing) Pow(x, e uint64) uint64 {
+	result := z.Reduce(1)
+	for x = z.Reduce(x); e > 0; e >>= 1 {
+		if e&1 == 1 {
+			result = z.Mul(result, x)
+		}
+		x = z.Mul(x, x)
+	}
+	return result
+}
+
+// Inverse returns the multiplicative inverse of x, which exists only when gcd(x, m) = 1
+func (z ModularRing) Inverse(x uint64) (uint64, error) {
+	// Extended Euclid on (x, m), tracking the coefficient of x modulo m
+	oldR, r := z.Reduce(x), z.m
+	oldS, s := uint64(1), uint64(0)
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldS, s = s, z.Add(oldS, z.m-z.Mul(z.Reduce(q), s))
+	}
+	if oldR != 1 {
+		return 0, fmt.Errorf("%d tidak memiliki invers modulo %d", x, z.m)
+	}
+	return oldS, nil
+}
+
+// GeometricSum returns a·(1 + r + ... + r^(n-1)) in the ring. The sum of powers is built by
+// doubling, S(2k) = S(k)·(1 + r^k), so no inverse of r - 1 is needed and it takes O(log n).
+func (z ModularRing) GeometricSum(a, r, n uint64) uint64 {
+	sum, power := uint64(0), z.Reduce(1) // S(k) dan r^k untuk awalan bit n yang sudah diproses
+	r = z.Reduce(r)
+	for i := bits.Len64(n) - 1; i >= 0; i-- {
+		sum = z.Mul(sum, z.Add(z.Reduce(1), power))
+		power = z.Mul(power, power)
+		if n>>i&1 == 1 {
+			sum = z.Add(z.Mul(sum, r), z.Reduce(1))
+			power = z.Mul(power, r)
+		}
+	}
+	return z.Mul(z.Reduce(a), sum)
+}
+
+// GeometricSumFormula returns a·(r^n - 1)·(r - 1)^-1 in the ring, which requires r - 1 to be
+// invertible modulo m
+func (z ModularRing) GeometricSumFormula(a, r, n uint64) (uint64, error) {
+	denom := z.Add(z.Reduce(r), z.m-z.Reduce(1))
+	inv, err := z.Inverse(denom)
+	if err != nil {
+		return 0, err
+	}
+	numer := z.Add(z.Pow(r, n), z.m-z.Reduce(1))
+	return z.Mul(z.Mul(z.Reduce(a), numer), inv), nil
+}
+
+// ModularProgram computes a geometric sum over Z/mZ and cross-checks it with the closed form
+// and, for small n, with direct term-by-term summation
+func ModularProgram() {
+	fmt.Println("\n=== Deret Geometri Modulo m ===")
+	var m, a, r, n uint64
+
+	for _, input := range []struct {
+		label string
+		dst   *uint64
+	}{
+		{"Modulus (m >= 2): ", &m},
+		{"Suku pertama (a): ", &a},
+		{"Rasio (r): ", &r},
+		{"Jumlah suku (n): ", &n},
+	} {
+		fmt.Print(input.label)
+		line, err := stdin.readLine()
+		if err != nil {
+			return
+		}
+		if _, err := fmt.Sscan(line, input.dst); err != nil {
+			fmt.Println("Error: harap masukkan bilangan bulat tak negatif")
+			return
+		}
+	}
+
+	ring, err := NewModularRing(m)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("\nPenggandaan (O(log n)):  %d\n", ring.GeometricSum(a, r, n))
+	if formula, err := ring.GeometricSumFormula(a, r, n); err == nil {
+		fmt.Printf("Rumus dengan invers:     %d\n", formula)
+	} else {
+		fmt.Printf("Rumus dengan invers:     tidak tersedia (%v)\n", err)
+	}
+	if n <= maxDirectModularN {
+		direct, term := uint64(0), ring.Reduce(a)
+		for i := uint64(0); i < n; i++ {
+			direct = ring.Add(direct, term)
+			term = ring.Mul(term, r)
+		}
+		fmt.Printf("Langsung suku demi suku: %d\n", direct)
+	}
+}
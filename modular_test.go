@@ -0,0 +1,95 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// directModularSum adds the n terms a·r^i one at a time in the ring
+func directModularSum(z ModularRing, a, r, n uint64) uint64 {
+	sum, term := uint64(0), z.Reduce(a)
+	for i := uint64(0); i < n; i++ {
+		sum = z.Add(sum, term)
+		term = z.Mul(term, z.Reduce(r))
+	}
+	return sum
+}
+
+func TestModularGeometricSum(t *testing.T) {
+	const prime64 = math.MaxUint64 - 58 // 2^64 - 59, the largest prime below 2^64
+	tests := []struct {
+		m, a, r uint64
+	}{
+		{7, 3, 2},
+		{1000000007, 12345, 67890},
+		{prime64, prime64 - 1, prime64 - 2},
+		{prime64, math.MaxUint64, 3}, // a and r above m must be reduced first
+		{math.MaxUint64, math.MaxUint64 - 1, 1 << 63},
+	}
+	for _, tt := range tests {
+		z, err := NewModularRing(tt.m)
+		if err != nil {
+			t.Fatalf("NewModularRing(%d): %v", tt.m, err)
+		}
+		for _, n := range []uint64{0, 1, 2, 3, 10, 64, 127, 1000} {
+			want := directModularSum(z, tt.a, tt.r, n)
+			if got := z.GeometricSum(tt.a, tt.r, n); got != want {
+				t.Errorf("m=%d a=%d r=%d n=%d: GeometricSum = %d; want %d", tt.m, tt.a, tt.r, n, got, want)
+			}
+			got, err := z.GeometricSumFormula(tt.a, tt.r, n)
+			if err != nil {
+				t.Fatalf("m=%d a=%d r=%d n=%d: GeometricSumFormula: %v", tt.m, tt.a, tt.r, n, err)
+			}
+			if got != want {
+				t.Errorf("m=%d a=%d r=%d n=%d: GeometricSumFormula = %d; want %d", tt.m, tt.a, tt.r, n, got, want)
+			}
+		}
+	}
+}
+
+func TestModularGeometricSumNonInvertible(t *testing.T) {
+	tests := []struct {
+		m, r uint64
+	}{
+		{12, 3},              // r - 1 = 2 shares a factor with 12
+		{12, 1},              // r - 1 = 0
+		{math.MaxUint64, 4},  // r - 1 = 3 divides 2^64 - 1
+		{1 << 40, 1<<40 + 1}, // r reduces to 1
+	}
+	for _, tt := range tests {
+		z, err := NewModularRing(tt.m)
+		if err != nil {
+			t.Fatalf("NewModularRing(%d): %v", tt.m, err)
+		}
+		if _, err := z.GeometricSumFormula(5, tt.r, 10); err == nil {
+			t.Errorf("m=%d r=%d: GeometricSumFormula accepted a non-invertible r - 1", tt.m, tt.r)
+		}
+		// The doubling method needs no inverse and still agrees with the direct sum
+		for _, n := range []uint64{1, 10, 333} {
+			if got, want := z.GeometricSum(5, tt.r, n), directModularSum(z, 5, tt.r, n); got != want {
+				t.Errorf("m=%d r=%d n=%d: GeometricSum = %d; want %d", tt.m, tt.r, n, got, want)
+			}
+		}
+	}
+}
+
+func TestModularInverse(t *testing.T) {
+	const prime64 = math.MaxUint64 - 58
+	for _, m := range []uint64{7, 1000000007, prime64} {
+		z, _ := NewModularRing(m)
+		for _, x := range []uint64{1, 2, 5, m - 1, m - 2} {
+			inv, err := z.Inverse(x)
+			if err != nil {
+				t.Fatalf("m=%d: Inverse(%d): %v", m, x, err)
+			}
+			if got := z.Mul(x, inv); got != 1 {
+				t.Errorf("m=%d: %d · Inverse(%d) = %d; want 1", m, x, x, got)
+			}
+		}
+	}
+
+	z, _ := NewModularRing(math.MaxUint64)
+	if _, err := z.Inverse(5); err == nil {
+		t.Error("Inverse(5) modulo 2^64 - 1 succeeded, but 5 divides the modulus")
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const maxPermutationN = 100 // Jumlah suku terbesar untuk permutasi yang diketik pengguna
+
+// SummationOrder selects the order in which the terms are accumulated
+type SummationOrder int
+
+const (
+	OrderNatural    SummationOrder = iota // Urutan suku apa adanya (1, 2, ..., n)
+	OrderAscending                        // Magnitudo terkecil lebih dulu
+	OrderDescending                       // Magnitudo terbesar lebih dulu
+	OrderPairwise                         // Reduksi berpasangan (pohon)
+)
+
+// summationOrders lists every preset order in display order
+var summationOrders = []SummationOrder{OrderNatural, OrderAscending, OrderDescending, OrderPairwise}
+
+// String returns the display name of the summation order
+func (o SummationOrder) String() string {
+	switch o {
+	case OrderNatural:
+		return "alami"
+	case OrderAscending:
+		return "magnitudo naik"
+	case OrderDescending:
+		return "magnitudo turun"
+	case OrderPairwise:
+		return "berpasangan"
+	default:
+		return "tidak dikenal"
+	}
+}
+
+// terms returns the n explicit terms a, a·r, ..., a·r^(n-1)
+func (g *GeometricCalculator) terms() []float64 {
+	terms := make([]float64, g.n)
+	term := g.a
+	for i := range terms {
+		terms[i] = term
+		term *= g.r
+	}
+	return terms
+}
+
+// pairwiseSum adds the halves of terms recursively, so rounding error grows with log n
+// rather than n
+func pairwiseSum(terms []float64) float64 {
+	switch len(terms) {
+	case 0:
+		return 0
+	case 1:
+		return terms[0]
+	}
+	mid := len(terms) / 2
+	return pairwiseSum(terms[:mid]) + pairwiseSum(terms[mid:])
+}
+
+// GeometricSumOrdered calculates the sum of the explicit terms accumulated in the given order
+func (g *GeometricCalculator) GeometricSumOrdered(order SummationOrder) float64 {
+	terms := g.terms()
+	switch order {
+	case OrderAscending:
+		sort.Slice(terms, func(i, j int) bool { return math.Abs(terms[i]) < math.Abs(terms[j]) })
+	case OrderDescending:
+		sort.Slice(terms, func(i, j int) bool { return math.Abs(terms[i]) > math.Abs(terms[j]) })
+	case OrderPairwise:
+		return pairwiseSum(terms)
+	}
+	sum := 0.0
+	for _, t := range terms {
+		sum += t
+	}
+	return sum
+}
+
+// GeometricSumPermuted accumulates the terms in the order given by perm, a permutation of
+// the 1-based term positions 1..n
+func (g *GeometricCalculator) GeometricSumPermuted(perm []int) (float64, error) {
+	if len(perm) != g.n {
+		return 0, fmt.Errorf("permutasi harus berisi %d indeks, diterima %d", g.n, len(perm))
+	}
+	seen := make([]bool, g.n)
+	terms := g.terms()
+	sum := 0.0
+	for _, p := range perm {
+		if p < 1 || p > g.n || seen[p-1] {
+			return 0, fmt.Errorf("bukan permutasi 1..%d: indeks %d tidak valid atau berulang", g.n, p)
+		}
+		seen[p-1] = true
+		sum += terms[p-1]
+	}
+	return sum, nil
+}
+
+// OrderProgram compares every preset summation order, and optionally a permutation typed by
+// the user, against the big.Float reference
+func OrderProgram() {
+	fmt.Println("\n=== Pengaruh Urutan Penjumlahan ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	ref := calc.GeometricSumBig(referencePrec)
+
+	fmt.Printf("\n%-16s %-24s %s\n", "Urutan", "Hasil", "Galat relatif")
+	for _, order := range summationOrders {
+		sum := calc.GeometricSumOrdered(order)
+		fmt.Printf("%-16s %-24.17g %.3e\n", order, sum, relativeError(sum, ref))
+	}
+
+	if n > maxPermutationN {
+		return
+	}
+	fmt.Printf("\nPermutasi indeks 1..%d dipisah spasi (kosong untuk lewati): ", n)
+	line, err := stdin.readLine()
+	if err != nil || line == "" {
+		return
+	}
+	var perm []int
+	for _, field := range strings.Fields(line) {
+		p, err := strconv.Atoi(field)
+		if err != nil {
+			fmt.Printf("Error: indeks tidak valid %q\n", field)
+			return
+		}
+		perm = append(perm, p)
+	}
+	sum, err := calc.GeometricSumPermuted(perm)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Printf("%-16s %-24.17g %.3e\n", "permutasi", sum, relativeError(sum, ref))
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"math"
+	"math/big"
+)
+
+const (
+	maxPositBits = 32 // Lebar posit terbesar yang didukung
+	maxPositES   = 4  // Jumlah bit eksponen terbesar yang didukung
+)
+
+// posit is a minimal software posit number with nbits total bits and es exponent bits.
+// Arithmetic is performed by decoding to float64, operating and rounding back, which is
+// exact enough for the widths supported here to compare accuracy with float64.
+type posit struct {
+	bits  uint64 // Pola bit posit dalam komplemen dua
+	nbits int    // Lebar total posit
+	es    int    // Jumlah bit eksponen
+}
+
+// validPositFormat reports whether nbits and es describe a supported posit format
+func validPositFormat(nbits, es int) bool {
+	return nbits >= 3 && nbits <= maxPositBits && es >= 0 && es <= maxPositES
+}
+
+// newPosit rounds x to the nearest posit of the given format (ties to even). Nonzero values
+// never round to zero or infinity; they saturate at minpos and maxpos instead.
+func newPosit(x float64, nbits, es int) posit {
+	p := posit{nbits: nbits, es: es}
+	mask := uint64(1)<<nbits - 1
+	switch {
+	case x == 0:
+		return p
+	case math.IsNaN(x) || math.IsInf(x, 0):
+		p.bits = uint64(1) << (nbits - 1) // NaR
+		return p
+	}
+
+	negative := x < 0
+	frac, exp := math.Frexp(math.Abs(x)) // |x| = frac·2^exp, frac di [0.5, 1)
+	scale := exp - 1
+	k := scale >> es // floor(scale / 2^es)
+	expo := scale - k<<es
+
+	maxpos := uint64(1)<<(nbits-1) - 1
+	var body uint64
+	// The regime alone fills the posit: saturate
+	if k >= nbits-2 {
+		body = maxpos
+	} else if -k >= nbits-1 {
+		body = 1
+	} else {
+		// Assemble regime, exponent and 52 fraction bits, then round to nbits-1 bits
+		v := new(big.Int)
+		regimeLen := 0
+		if k >= 0 {
+			regimeLen = k + 2
+			v.Lsh(big.NewInt(1), uint(k+1))
+			v.Sub(v, big.NewInt(1))
+			v.Lsh(v, 1) // k+1 satu diikuti nol
+		} else {
+			regimeLen = -k + 1
+			v.SetInt64(1) // -k nol diikuti satu
+		}
+		v.Lsh(v, uint(es)).Or(v, big.NewInt(int64(expo)))
+		fracBits := uint64((frac*2 - 1) * (1 << 52))
+		v.Lsh(v, 52).Or(v, new(big.Int).SetUint64(fracBits))
+
+		shift := regimeLen + es + 52 - (nbits - 1)
+		if shift > 0 {
+			rem := new(big.Int).And(v, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(shift)), big.NewInt(1)))
+			v.Rsh(v, uint(shift))
+			half := new(big.Int).Lsh(big.NewInt(1), uint(shift-1))
+			if c := rem.Cmp(half); c > 0 || (c == 0 && v.Bit(0) == 1) {
+				v.Add(v, big.NewInt(1))
+			}
+		} else {
+			v.Lsh(v, uint(-shift))
+		}
+		body = v.Uint64()
+		if body > maxpos {
+			body = maxpos
+		}
+		if body == 0 {
+			body = 1
+		}
+	}
+
+	if negative {
+		body = -body & mask
+	}
+	p.bits = body
+	return p
+}
+
+// Float64 decodes the posit to the float64 it represents
+func (p posit) Float64() float64 {
+	mask := uint64(1)<<p.nbits - 1
+	signBit := uint64(1) << (p.nbits - 1)
+	switch p.bits {
+	case 0:
+		return 0
+	case signBit:
+		return math.NaN()
+	}
+
+	bits := p.bits
+	negative := bits&signBit != 0
+	if negative {
+		bits = -bits & mask
+	}
+
+	// Decode the regime run that follows the sign bit
+	pos := p.nbits - 2
+	first := bits >> pos & 1
+	run := 0
+	for pos >= 0 && bits>>pos&1 == first {
+		run++
+		pos--
+	}
+	pos-- // lewati bit penutup regime
+	k := run - 1
+	if first == 0 {
+		k = -run
+	}
+
+	// Remaining bits hold the exponent (possibly truncated) and then the fraction
+	expo := 0
+	for i := 0; i < p.es; i++ {
+		expo <<= 1
+		if pos >= 0 {
+			expo |= int(bits >> pos & 1)
+			pos--
+		}
+	}
+	fraction := 1.0
+	for weight := 0.5; pos >= 0; weight /= 2 {
+		if bits>>pos&1 == 1 {
+			fraction += weight
+		}
+		pos--
+	}
+
+	v := math.Ldexp(fraction, k<<p.es+expo)
+	if negative {
+		v = -v
+	}
+	return v
+}
+
+// GeometricSumPosit calculates the sum iteratively with the terms, the ratio and every partial
+// sum rounded to posits of nbits bits with es exponent bits. It returns NaN for unsupported
+// formats.
+func (g *GeometricCalculator) GeometricSumPosit(nbits, es int) float64 {
+	if !validPositFormat(nbits, es) {
+		return math.NaN()
+	}
+	r := newPosit(g.r, nbits, es)
+	term := newPosit(g.a, nbits, es)
+	sum := newPosit(0, nbits, es)
+	for i := 0; i < g.n; i++ {
+		sum = newPosit(sum.Float64()+term.Float64(), nbits, es)
+		term = newPosit(term.Float64()*r.Float64(), nbits, es)
+	}
+	return sum.Float64()
+}
+
+// GeometricSumFloat32 calculates the sum iteratively entirely in float32, the IEEE format of
+// the same width as a 32-bit posit
+func (g *GeometricCalculator) GeometricSumFloat32() float64 {
+	var sum float32
+	term := float32(g.a)
+	r := float32(g.r)
+	for i := 0; i < g.n; i++ {
+		sum += term
+		term *= r
+	}
+	return float64(sum)
+}
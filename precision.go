@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+const float64SigFigs = 15 // Digit signifikan desimal yang selalu dijamin float64
+
+// decimalBig converts v to a big.Float through its shortest decimal representation, so an
+// input typed as 0.1 is treated as exactly one tenth rather than its float64 approximation
+func decimalBig(v float64, prec uint) *big.Float {
+	f, _, err := big.ParseFloat(strconv.FormatFloat(v, 'g', -1, 64), 10, prec, big.ToNearestEven)
+	if err != nil {
+		return new(big.Float).SetPrec(prec).SetFloat64(v)
+	}
+	return f
+}
+
+// geometricSumDecimal calculates the sum iteratively in big.Float arithmetic with prec bits,
+// treating a and r as the decimal values they were entered as
+func (g *GeometricCalculator) geometricSumDecimal(prec uint) *big.Float {
+	sum := new(big.Float).SetPrec(prec)
+	term := decimalBig(g.a, prec)
+	r := decimalBig(g.r, prec)
+	for i := 0; i < g.n; i++ {
+		sum.Add(sum, term)
+		term.Mul(term, r)
+	}
+	return sum
+}
+
+// bitsForSigFigs returns the mantissa bits needed for sigfigs decimal digits plus guard bits
+// to absorb rounding accumulated over the summation
+func bitsForSigFigs(sigfigs, n int) uint {
+	guard := 16 + int(math.Ceil(math.Log2(float64(n)+1)))
+	return uint(math.Ceil(float64(sigfigs)*math.Log2(10))) + uint(guard)
+}
+
+// SigFigsProgram prints the sum with the requested number of significant digits. Requests
+// beyond float64's precision are escalated automatically to big.Float.
+func SigFigsProgram(calc *GeometricCalculator, sigfigs int) error {
+	if sigfigs <= 0 {
+		return fmt.Errorf("-sigfigs harus > 0")
+	}
+
+	if sigfigs <= float64SigFigs {
+		fmt.Println(strconv.FormatFloat(calc.GeometricSumFormula(), 'g', sigfigs, 64))
+		return nil
+	}
+
+	prec := bitsForSigFigs(sigfigs, calc.n)
+	fmt.Printf("Catatan: %d digit melebihi presisi float64 (~%d digit); dihitung ulang dengan big.Float %d bit\n",
+		sigfigs, float64SigFigs, prec)
+	fmt.Println(calc.geometricSumDecimal(prec).Text('g', sigfigs))
+	return nil
+}
+
+const float64MantissaBits = 52 // Bit mantissa (tanpa bit implisit) pada float64
+
+// truncateMantissa keeps the top bits mantissa bits of x and clears the rest, simulating a
+// float format with a shorter mantissa that rounds toward zero
+func truncateMantissa(x float64, bits int) float64 {
+	mask := ^uint64(0) << (float64MantissaBits - bits)
+	return math.Float64frombits(math.Float64bits(x) & mask)
+}
+
+// GeometricSumReducedMantissa calculates the sum iteratively with the mantissa of every
+// partial sum and term truncated to bits bits (0-52) after each operation. It returns NaN
+// for an out-of-range bits.
+func (g *GeometricCalculator) GeometricSumReducedMantissa(bits int) float64 {
+	if bits < 0 || bits > float64MantissaBits {
+		return math.NaN()
+	}
+	sum := 0.0
+	term := truncateMantissa(g.a, bits)
+	r := truncateMantissa(g.r, bits)
+	for i := 0; i < g.n; i++ {
+		sum = truncateMantissa(sum+term, bits)
+		term = truncateMantissa(term*r, bits)
+	}
+	return sum
+}
+
+// ReducedMantissaProgram compares a sum computed with a shortened mantissa against full float64
+func ReducedMantissaProgram() {
+	fmt.Println("\n=== Simulasi Mantissa Tereduksi ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var bits int
+	fmt.Printf("Bit mantissa (0-%d): ", float64MantissaBits)
+	if err := stdin.readInt(&bits); err != nil || bits < 0 || bits > float64MantissaBits {
+		fmt.Printf("Error: harap masukkan jumlah bit antara 0 dan %d\n", float64MantissaBits)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	ref := calc.GeometricSumBig(referencePrec)
+	reduced := calc.GeometricSumReducedMantissa(bits)
+	full := calc.GeometricSumIterative()
+
+	fmt.Printf("\n%-16s %-24s %s\n", "Format", "Hasil", "Galat relatif")
+	fmt.Printf("%-16s %-24.17g %.3e\n", fmt.Sprintf("%d bit mantissa", bits), reduced, relativeError(reduced, ref))
+	fmt.Printf("%-16s %-24.17g %.3e\n", "float64", full, relativeError(full, ref))
+	fmt.Printf("\nPerkiraan digit desimal yang tersisa: %.1f\n", float64(bits+1)*math.Log10(2))
+}
+
+const smallestNormalFloat64 = math.SmallestNonzeroFloat64 * (1 << 52) // 2^-1022, awal rentang denormal
+
+// GeometricSumFlushToZero calculates the sum iteratively as on hardware that flushes denormals
+// to zero: every term whose magnitude falls below the smallest normal float64 becomes exactly 0
+func (g *GeometricCalculator) GeometricSumFlushToZero() float64 {
+	sum, _ := g.geometricSumFlushToZero()
+	return sum
+}
+
+// geometricSumFlushToZero is GeometricSumFlushToZero that also counts the flushed terms
+func (g *GeometricCalculator) geometricSumFlushToZero() (float64, int) {
+	sum, term := 0.0, g.a
+	flushed := 0
+	for i := 0; i < g.n; i++ {
+		if term != 0 && math.Abs(term) < smallestNormalFloat64 {
+			term = 0
+			flushed++
+		}
+		sum += term
+		term *= g.r
+	}
+	return sum, flushed
+}
+
+// FlushToZeroProgram compares the iterative sum with and without denormal flush-to-zero
+func FlushToZeroProgram() {
+	fmt.Println("\n=== Simulasi Flush-to-Zero Denormal ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	normal := calc.GeometricSumIterative()
+	ftz, flushed := calc.geometricSumFlushToZero()
+
+	fmt.Printf("\nNormal:        %.17g\n", normal)
+	fmt.Printf("Flush-to-zero: %.17g\n", ftz)
+	fmt.Printf("Suku yang dinolkan: %d dari %d\n", flushed, n)
+	if flushed == 0 {
+		fmt.Println("Tidak ada suku di rentang denormal; kedua hasil identik")
+		return
+	}
+	fmt.Printf("Selisih mutlak: %.3e\n", math.Abs(normal-ftz))
+	fmt.Printf("Selisih relatif: %.3e\n", relDiff(normal, ftz))
+}
@@ -0,0 +1,70 @@
+package main
+
+import "math"
+
+// precisionWarnTol is the relative disagreement between the formula and the compensated
+// iterative sum above which a result is flagged as losing precision
+const precisionWarnTol = 1e-12
+
+// Reliability describes how far a computed sum can be trusted
+type Reliability int
+
+const (
+	Exact            Reliability = iota // Tidak ada pembulatan sama sekali
+	GoodApprox                          // Dibulatkan, tetapi dalam presisi float64
+	PrecisionWarning                    // Metode tidak sepakat; presisi hilang
+	Overflow                            // Hasil melebihi jangkauan float64
+)
+
+// String returns the display name of the reliability level
+func (rel Reliability) String() string {
+	switch rel {
+	case Exact:
+		return "eksak"
+	case GoodApprox:
+		return "aproksimasi baik"
+	case PrecisionWarning:
+		return "peringatan presisi"
+	case Overflow:
+		return "overflow"
+	default:
+		return "tidak dikenal"
+	}
+}
+
+// overflows reports whether the sum or any term exceeds the float64 range
+func (g *GeometricCalculator) overflows(sum float64) bool {
+	lastTerm := g.a * math.Pow(g.r, float64(g.n-1))
+	return math.IsInf(sum, 0) || math.IsNaN(sum) || math.IsInf(lastTerm, 0)
+}
+
+// isExact reports whether every term and partial sum is exactly representable, which holds
+// when rounding up and rounding down every operation give the same result
+func (g *GeometricCalculator) isExact() bool {
+	return g.GeometricSumRounded(RoundUp) == g.GeometricSumRounded(RoundDown)
+}
+
+// losesPrecision reports whether the formula and the Kahan sum disagree beyond precisionWarnTol
+func (g *GeometricCalculator) losesPrecision(sum float64) bool {
+	return relDiff(sum, g.GeometricSumKahan()) > precisionWarnTol
+}
+
+// ComputeSum calculates the sum with the closed-form formula and assesses its reliability by
+// combining the overflow, exactness and precision-loss checks
+func (g *GeometricCalculator) ComputeSum() (value float64, reliability Reliability, err error) {
+	if err := checkParams(g.a, g.r, g.n); err != nil {
+		return 0, 0, err
+	}
+
+	value = g.GeometricSumFormula()
+	switch {
+	case g.overflows(value):
+		return value, Overflow, nil
+	case g.isExact():
+		return g.GeometricSumRounded(RoundNearest), Exact, nil
+	case g.losesPrecision(value):
+		return value, PrecisionWarning, nil
+	default:
+		return value, GoodApprox, nil
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MethodReport holds the benchmark statistics and accuracy of one registered method
+type MethodReport struct {
+	Name     string    `json:"name"`
+	Label    string    `json:"label"`
+	Result   float64   `json:"result"`
+	Times    []float64 `json:"times_ns"`
+	Mean     float64   `json:"mean_ns"`
+	StdDev   float64   `json:"stddev_ns"`
+	Min      float64   `json:"min_ns"`
+	Max      float64   `json:"max_ns"`
+	RelError float64   `json:"relative_error"`
+	Skipped  bool      `json:"skipped"`
+}
+
+// FullReport is the complete benchmark report of every registered method, ranked by mean time
+type FullReport struct {
+	Timestamp time.Time         `json:"timestamp"`
+	Inputs    ManifestInputs    `json:"inputs"`
+	Benchmark ManifestBenchmark `json:"benchmark"`
+	Machine   ManifestMachine   `json:"machine"`
+	Reference float64           `json:"reference"`
+	Methods   []MethodReport    `json:"methods"`
+}
+
+// timingStats returns the mean, standard deviation, minimum and maximum of times
+func timingStats(times []float64) (mean, stddev, min, max float64) {
+	min, max = math.Inf(1), math.Inf(-1)
+	for _, t := range times {
+		mean += t
+		min = math.Min(min, t)
+		max = math.Max(max, t)
+	}
+	mean /= float64(len(times))
+	for _, t := range times {
+		stddev += (t - mean) * (t - mean)
+	}
+	stddev = math.Sqrt(stddev / float64(len(times)))
+	return mean, stddev, min, max
+}
+
+// BuildFullReport benchmarks every method in sumMethods cfg.Runs times and ranks them by mean
+// time. Recursive methods are skipped when n exceeds cfg.MaxRecursionDepth.
+func BuildFullReport(calc *GeometricCalculator, cfg BenchmarkConfig) FullReport {
+	manifest := NewManifest(Result{A: calc.a, R: calc.r, N: calc.n}, cfg)
+	ref := calc.GeometricSumBig(referencePrec)
+	refValue, _ := ref.Float64()
+
+	report := FullReport{
+		Timestamp: manifest.Timestamp,
+		Inputs:    manifest.Inputs,
+		Benchmark: manifest.Benchmark,
+		Machine:   manifest.Machine,
+		Reference: refValue,
+	}
+
+	for _, m := range sumMethods {
+		mr := MethodReport{Name: m.Name, Label: m.Label}
+		if m.Recursive && calc.n > cfg.MaxRecursionDepth {
+			mr.Skipped = true
+			report.Methods = append(report.Methods, mr)
+			continue
+		}
+
+		sum := m.Sum
+		mr.Times = make([]float64, cfg.Runs)
+		for i := range mr.Times {
+			mr.Times[i] = measureExecutionTimeWith(func() {
+				mr.Result = sum(calc)
+			}, cfg.WarmUpRuns, cfg.Iterations)
+		}
+		mr.Mean, mr.StdDev, mr.Min, mr.Max = timingStats(mr.Times)
+		mr.RelError = relativeError(mr.Result, ref)
+		report.Methods = append(report.Methods, mr)
+	}
+
+	// Rank measured methods by mean time, keeping skipped methods last
+	sort.SliceStable(report.Methods, func(i, j int) bool {
+		mi, mj := report.Methods[i], report.Methods[j]
+		if mi.Skipped != mj.Skipped {
+			return !mi.Skipped
+		}
+		return mi.Mean < mj.Mean
+	})
+	return report
+}
+
+// Markdown renders the report as a markdown document
+func (fr FullReport) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Laporan Lengkap Deret Geometri\n\n")
+	fmt.Fprintf(&b, "Waktu: %s\n\n", fr.Timestamp.Format(time.RFC3339))
+
+	fmt.Fprintf(&b, "## Parameter\n\n")
+	fmt.Fprintf(&b, "- a = %g\n- r = %g\n- n = %d\n\n", fr.Inputs.A, fr.Inputs.R, fr.Inputs.N)
+
+	fmt.Fprintf(&b, "## Konfigurasi\n\n")
+	fmt.Fprintf(&b, "- Pengujian: %d\n- Pemanasan: %d\n- Iterasi: %d\n- Batas kedalaman rekursi: %d\n\n",
+		fr.Benchmark.Runs, fr.Benchmark.WarmUpRuns, fr.Benchmark.Iterations, fr.Benchmark.MaxRecursionDepth)
+
+	fmt.Fprintf(&b, "## Mesin\n\n")
+	fmt.Fprintf(&b, "- CPU: %d\n- Go: %s\n- OS/Arch: %s/%s\n\n",
+		fr.Machine.NumCPU, fr.Machine.GoVersion, fr.Machine.OS, fr.Machine.Arch)
+
+	fmt.Fprintf(&b, "## Hasil\n\n")
+	fmt.Fprintf(&b, "Acuan big.Float: %.17g\n\n", fr.Reference)
+	fmt.Fprintf(&b, "| Peringkat | Metode | Hasil | Rata-rata (ns) | Simpangan (ns) | Min (ns) | Maks (ns) | Galat relatif |\n")
+	fmt.Fprintf(&b, "|---|---|---|---|---|---|---|---|\n")
+	for i, m := range fr.Methods {
+		if m.Skipped {
+			fmt.Fprintf(&b, "| - | %s | dilewati | - | - | - | - | - |\n", m.Label)
+			continue
+		}
+		fmt.Fprintf(&b, "| %d | %s | %.17g | %.3f | %.3f | %.3f | %.3f | %.3e |\n",
+			i+1, m.Label, m.Result, m.Mean, m.StdDev, m.Min, m.Max, m.RelError)
+	}
+	return b.String()
+}
+
+// Write saves the report to path as JSON when the extension is .json, otherwise as markdown
+func (fr FullReport) Write(path string) error {
+	var data []byte
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var err error
+		if data, err = json.MarshalIndent(fr, "", "  "); err != nil {
+			return fmt.Errorf("gagal menyusun laporan: %v", err)
+		}
+		data = append(data, '\n')
+	} else {
+		data = []byte(fr.Markdown())
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("gagal menulis laporan %s: %v", path, err)
+	}
+	return nil
+}
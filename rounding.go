@@ -0,0 +1,78 @@
+package main
+
+import "math"
+
+// RoundingMode selects how each floating-point result is rounded
+type RoundingMode int
+
+const (
+	RoundNearest    RoundingMode = iota // Pembulatan ke terdekat (bawaan IEEE 754)
+	RoundTowardZero                     // Pembulatan menuju nol
+	RoundUp                             // Pembulatan ke atas (menuju +∞)
+	RoundDown                           // Pembulatan ke bawah (menuju -∞)
+)
+
+// roundingModes lists every mode in display order
+var roundingModes = []RoundingMode{RoundNearest, RoundTowardZero, RoundUp, RoundDown}
+
+// String returns the display name of the rounding mode
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundNearest:
+		return "ke terdekat"
+	case RoundTowardZero:
+		return "menuju nol"
+	case RoundUp:
+		return "ke atas"
+	case RoundDown:
+		return "ke bawah"
+	default:
+		return "tidak dikenal"
+	}
+}
+
+// roundDirected adjusts the round-to-nearest result v of an operation whose rounding error
+// (exact minus v) is err, so that v is rounded according to mode instead
+func roundDirected(v, err float64, mode RoundingMode) float64 {
+	switch {
+	case err == 0 || mode == RoundNearest:
+		return v
+	case mode == RoundUp && err > 0:
+		return math.Nextafter(v, math.Inf(1))
+	case mode == RoundDown && err < 0:
+		return math.Nextafter(v, math.Inf(-1))
+	case mode == RoundTowardZero && v > 0 && err < 0:
+		return math.Nextafter(v, 0)
+	case mode == RoundTowardZero && v < 0 && err > 0:
+		return math.Nextafter(v, 0)
+	}
+	return v
+}
+
+// roundedAdd returns x + y rounded according to mode. The exact rounding error is recovered
+// with Knuth's TwoSum.
+func roundedAdd(x, y float64, mode RoundingMode) float64 {
+	s := x + y
+	bv := s - x
+	err := (x - (s - bv)) + (y - bv)
+	return roundDirected(s, err, mode)
+}
+
+// roundedMul returns x * y rounded according to mode. The exact rounding error is recovered
+// with a fused multiply-add.
+func roundedMul(x, y float64, mode RoundingMode) float64 {
+	p := x * y
+	return roundDirected(p, math.FMA(x, y, -p), mode)
+}
+
+// GeometricSumRounded calculates the sum iteratively with every addition and multiplication
+// rounded according to mode, simulating directed rounding with math.Nextafter
+func (g *GeometricCalculator) GeometricSumRounded(mode RoundingMode) float64 {
+	sum := 0.0
+	term := g.a
+	for i := 0; i < g.n; i++ {
+		sum = roundedAdd(sum, term, mode)
+		term = roundedMul(term, g.r, mode)
+	}
+	return sum
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// EstimatedSumSampled estimates the sum from only every k-th term, computed exactly as
+// a·r^i, plus the last term. The terms skipped between two samples are interpolated
+// geometrically and each block is summed in closed form, so the cost is O(n/k). When two
+// neighbouring samples do not share a sign the block falls back to linear interpolation.
+func (g *GeometricCalculator) EstimatedSumSampled(k int) float64 {
+	if k < 1 {
+		k = 1
+	}
+	term := func(i int) float64 {
+		return g.a * math.Pow(g.r, float64(i))
+	}
+
+	sum := 0.0
+	start, first := 0, term(0)
+	for start < g.n-1 {
+		end := start + k
+		if end > g.n-1 {
+			end = g.n - 1
+		}
+		last := term(end)
+		length := float64(end - start)
+
+		// Sum the interpolated terms start..end-1 of the block
+		if ratio := last / first; ratio > 0 {
+			q := math.Pow(ratio, 1/length)
+			if math.Abs(q-1) < epsilon {
+				sum += first * length
+			} else {
+				sum += first * (1 - math.Pow(q, length)) / (1 - q)
+			}
+		} else {
+			sum += length*first + (last-first)*(length-1)/2
+		}
+		start, first = end, last
+	}
+	return sum + first
+}
+
+// SampledSumProgram compares the sampled estimate with the exact iterative sum in accuracy
+// and speed
+func SampledSumProgram() {
+	fmt.Println("\n=== Estimasi Jumlah dari Suku Sampel ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	var k int
+	fmt.Print("Jarak sampel (k >= 1): ")
+	if err := stdin.readInt(&k); err != nil || k < 1 {
+		fmt.Println("Error: harap masukkan nilai k >= 1")
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	ref := calc.GeometricSumBig(referencePrec)
+	var estimate, exact float64
+	estimateTime := averageTime(func() {
+		estimate = calc.EstimatedSumSampled(k)
+	})
+	exactTime := averageTime(func() {
+		exact = calc.GeometricSumIterative()
+	})
+
+	fmt.Printf("\n%-12s %-24s %-14s %s\n", "Metode", "Hasil", "Waktu (ns)", "Galat relatif")
+	fmt.Printf("%-12s %-24.17g %-14.3f %.3e\n", "Sampel", estimate, estimateTime, relativeError(estimate, ref))
+	fmt.Printf("%-12s %-24.17g %-14.3f %.3e\n", "Iteratif", exact, exactTime, relativeError(exact, ref))
+	fmt.Printf("\nSuku yang dihitung langsung: %d dari %d\n", (n-1+k-1)/k+1, n)
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+const maxSegmentTreeN = 1000000 // Jumlah suku terbesar yang disimpan dalam pohon segmen
+
+// TermSegmentTree stores the explicit terms of a series in a segment tree so that range sums
+// can be queried in O(log n) and individual terms modified in O(log n)
+type TermSegmentTree struct {
+	n    int       // Jumlah suku
+	tree []float64 // tree[n+i] adalah suku ke-(i+1); tree[k] = tree[2k] + tree[2k+1]
+}
+
+// NewTermSegmentTree builds the tree over the n terms a, a·r, ..., a·r^(n-1) in O(n)
+func NewTermSegmentTree(a, r float64, n int) *TermSegmentTree {
+	t := &TermSegmentTree{n: n, tree: make([]float64, 2*n)}
+	term := a
+	for i := 0; i < n; i++ {
+		t.tree[n+i] = term
+		term *= r
+	}
+	for k := n - 1; k > 0; k-- {
+		t.tree[k] = t.tree[2*k] + t.tree[2*k+1]
+	}
+	return t
+}
+
+// RangeSum returns the sum of terms i through j (1-based, inclusive)
+func (t *TermSegmentTree) RangeSum(i, j int) (float64, error) {
+	if i < 1 || j < i || j > t.n {
+		return 0, fmt.Errorf("rentang harus memenuhi 1 <= i <= j <= %d", t.n)
+	}
+	sum := 0.0
+	for lo, hi := t.n+i-1, t.n+j; lo < hi; lo, hi = lo/2, hi/2 {
+		if lo%2 == 1 {
+			sum += t.tree[lo]
+			lo++
+		}
+		if hi%2 == 1 {
+			hi--
+			sum += t.tree[hi]
+		}
+	}
+	return sum, nil
+}
+
+// Update replaces term i (1-based) with value and refreshes the affected nodes
+func (t *TermSegmentTree) Update(i int, value float64) error {
+	if i < 1 || i > t.n {
+		return fmt.Errorf("indeks suku harus di antara 1 dan %d", t.n)
+	}
+	k := t.n + i - 1
+	t.tree[k] = value
+	for k /= 2; k > 0; k /= 2 {
+		t.tree[k] = t.tree[2*k] + t.tree[2*k+1]
+	}
+	return nil
+}
+
+// closedRangeSum calculates the sum of terms i through j of the unmodified series with the
+// closed-form formula a·r^(i-1)·(1 - r^(j-i+1)) / (1 - r)
+func (g *GeometricCalculator) closedRangeSum(i, j int) float64 {
+	first := g.a * math.Pow(g.r, float64(i-1))
+	count := float64(j - i + 1)
+	if math.Abs(g.r-1.0) < epsilon {
+		return first * count
+	}
+	return first * (1 - math.Pow(g.r, count)) / (1 - g.r)
+}
+
+// SegmentTreeProgram answers range-sum queries from a segment tree, cross-checks them against
+// the closed form and then demonstrates a point update
+func SegmentTreeProgram() {
+	fmt.Println("\n=== Kueri Rentang dengan Pohon Segmen ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if n > maxSegmentTreeN {
+		fmt.Printf("Error: n harus <= %d untuk pohon segmen\n", maxSegmentTreeN)
+		return
+	}
+
+	var i, j int
+	fmt.Printf("Suku awal rentang (1-%d): ", n)
+	if err := stdin.readInt(&i); err != nil {
+		fmt.Println("Error: harap masukkan bilangan bulat")
+		return
+	}
+	fmt.Printf("Suku akhir rentang (%d-%d): ", i, n)
+	if err := stdin.readInt(&j); err != nil {
+		fmt.Println("Error: harap masukkan bilangan bulat")
+		return
+	}
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	tree := NewTermSegmentTree(a, r, n)
+	sum, err := tree.RangeSum(i, j)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	closed := calc.closedRangeSum(i, j)
+	fmt.Printf("\nPohon segmen:  %.10f\n", sum)
+	fmt.Printf("Rumus:         %.10f (selisih relatif %.3e)\n", closed, relDiff(sum, closed))
+
+	var k int
+	var value float64
+	fmt.Printf("\nUbah suku ke- (1-%d, 0 untuk lewati): ", n)
+	if err := stdin.readInt(&k); err != nil || k == 0 {
+		return
+	}
+	fmt.Print("Nilai baru: ")
+	if err := stdin.readFloat(&value); err != nil {
+		fmt.Println("Error: harap masukkan nilai yang valid")
+		return
+	}
+	if err := tree.Update(k, value); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	sum, _ = tree.RangeSum(i, j)
+	fmt.Printf("Jumlah rentang %d..%d setelah perubahan: %.10f\n", i, j, sum)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTermSegmentTreeRangeSum(t *testing.T) {
+	for _, n := range []int{1, 2, 7, 10, 13, 16} {
+		calc := &GeometricCalculator{a: 3, r: 0.8, n: n}
+		tree := NewTermSegmentTree(calc.a, calc.r, n)
+		for i := 1; i <= n; i++ {
+			for j := i; j <= n; j++ {
+				got, err := tree.RangeSum(i, j)
+				if err != nil {
+					t.Fatalf("n=%d RangeSum(%d, %d): %v", n, i, j, err)
+				}
+				if want := calc.closedRangeSum(i, j); relDiff(got, want) > 1e-12 {
+					t.Errorf("n=%d RangeSum(%d, %d) = %v; want %v", n, i, j, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestTermSegmentTreeUpdate(t *testing.T) {
+	const n = 11
+	calc := &GeometricCalculator{a: 2, r: 1.5, n: n}
+	tree := NewTermSegmentTree(calc.a, calc.r, n)
+
+	const updated, value = 6, -40.0
+	if err := tree.Update(updated, value); err != nil {
+		t.Fatalf("Update(%d, %v): %v", updated, value, err)
+	}
+	delta := value - calc.a*math.Pow(calc.r, updated-1)
+
+	for i := 1; i <= n; i++ {
+		for j := i; j <= n; j++ {
+			got, err := tree.RangeSum(i, j)
+			if err != nil {
+				t.Fatalf("RangeSum(%d, %d): %v", i, j, err)
+			}
+			want := calc.closedRangeSum(i, j)
+			if i <= updated && updated <= j {
+				want += delta
+			}
+			if math.Abs(got-want) > 1e-9 {
+				t.Errorf("after Update, RangeSum(%d, %d) = %v; want %v", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestTermSegmentTreeRejectsBadIndices(t *testing.T) {
+	tree := NewTermSegmentTree(1, 0.5, 5)
+	for _, rng := range [][2]int{{0, 3}, {3, 2}, {1, 6}} {
+		if _, err := tree.RangeSum(rng[0], rng[1]); err == nil {
+			t.Errorf("RangeSum(%d, %d) accepted", rng[0], rng[1])
+		}
+	}
+	for _, i := range []int{0, 6} {
+		if err := tree.Update(i, 1); err == nil {
+			t.Errorf("Update(%d) accepted", i)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+const maxTableN = 1000 // Jumlah suku terbesar yang disimpan dalam tabel
+
+// TableCalculator serves geometric sums for n = 1..maxTableN from a table built once at
+// construction, trading memory for constant-time lookups
+type TableCalculator struct {
+	a    float64   // Suku pertama
+	r    float64   // Rasio
+	sums []float64 // sums[n] adalah jumlah n suku pertama
+}
+
+// NewTableCalculator precomputes the sums of the first 1..maxTableN terms
+func NewTableCalculator(a, r float64) *TableCalculator {
+	t := &TableCalculator{a: a, r: r, sums: make([]float64, maxTableN+1)}
+	term := a
+	for n := 1; n <= maxTableN; n++ {
+		t.sums[n] = t.sums[n-1] + term
+		term *= r
+	}
+	return t
+}
+
+// Sum returns the precomputed sum of the first n terms
+func (t *TableCalculator) Sum(n int) (float64, error) {
+	if n < 1 || n > maxTableN {
+		return 0, fmt.Errorf("n harus di antara 1 dan %d untuk tabel", maxTableN)
+	}
+	return t.sums[n], nil
+}
+
+// TableProgram compares table lookups with the closed-form formula, including the
+// number of queries needed to amortize the table's construction cost
+func TableProgram() {
+	fmt.Println("\n=== Tabel Pencarian vs Rumus ===")
+	a, r, n, err := validateInput(stdin, false)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if n > maxTableN {
+		fmt.Printf("Error: n harus <= %d untuk tabel\n", maxTableN)
+		return
+	}
+
+	start := time.Now()
+	table := NewTableCalculator(a, r)
+	buildTime := float64(time.Since(start).Nanoseconds())
+
+	calc := &GeometricCalculator{a: a, r: r, n: n}
+	var lookup, formula float64
+	lookupTime := averageTime(func() {
+		lookup, _ = table.Sum(n)
+	})
+	formulaTime := averageTime(func() {
+		formula = calc.GeometricSumFormula()
+	})
+
+	fmt.Printf("\nPembangunan tabel (n=1..%d): %.0f ns\n", maxTableN, buildTime)
+	fmt.Printf("Pencarian tabel: %.10f (waktu: %.3f ns)\n", lookup, lookupTime)
+	fmt.Printf("Rumus:           %.10f (waktu: %.3f ns)\n", formula, formulaTime)
+
+	if saving := formulaTime - lookupTime; saving > 0 {
+		fmt.Printf("\nTabel lebih cepat %.3f ns per kueri; biaya pembangunan tertutup setelah sekitar %.0f kueri\n",
+			saving, buildTime/saving)
+	} else {
+		fmt.Println("\nRumus tidak kalah cepat dari pencarian tabel; tabel tidak menguntungkan di mesin ini")
+	}
+}